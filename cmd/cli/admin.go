@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+)
+
+func adminCmds(parent *kingpin.CmdClause, cmds map[string]sysadminCmd) {
+	grant := parent.Command("grant", "grant a city admin role to a user without an invite")
+	grantCity := grant.Arg("city_id", "city id").Required().String()
+	grantUser := grant.Arg("user_id", "user id").Required().String()
+	grantRole := grant.Flag("role", "city admin role").Required().Enum(enum.GetAllCityAdminRoles()...)
+
+	cmds[grant.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityID, err := parseUUID("city_id", *grantCity)
+		if err != nil {
+			return err
+		}
+		userID, err := parseUUID("user_id", *grantUser)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.admin.Create(ctx, userID, cityID, *grantRole)
+		if err != nil {
+			return err
+		}
+
+		return out.admins(res, res)
+	}
+
+	revoke := parent.Command("revoke", "remove a city admin")
+	revokeCity := revoke.Arg("city_id", "city id").Required().String()
+	revokeUser := revoke.Arg("user_id", "user id").Required().String()
+
+	cmds[revoke.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityID, err := parseUUID("city_id", *revokeCity)
+		if err != nil {
+			return err
+		}
+		userID, err := parseUUID("user_id", *revokeUser)
+		if err != nil {
+			return err
+		}
+
+		if err = d.admin.DeleteBySysAdmin(ctx, userID, cityID); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out.w, "city admin %s removed from city %s\n", userID, cityID)
+		return err
+	}
+
+	list := parent.Command("list", "list city admins")
+	listCity := list.Flag("city", "filter by city id, repeatable").Strings()
+	listUser := list.Flag("user", "filter by user id, repeatable").Strings()
+	listRole := list.Flag("role", "filter by role, repeatable").Enums(enum.GetAllCityAdminRoles()...)
+	listPage := list.Flag("page", "page number").Default("1").Uint64()
+	listSize := list.Flag("size", "page size").Default("20").Uint64()
+
+	cmds[list.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityIDs, err := parseUUIDs("city", *listCity)
+		if err != nil {
+			return err
+		}
+		userIDs, err := parseUUIDs("user", *listUser)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.admin.Filter(ctx, admin.FilterParams{
+			CityID: cityIDs,
+			UserID: userIDs,
+			Roles:  *listRole,
+		}, *listPage, *listSize)
+		if err != nil {
+			return err
+		}
+
+		return out.admins(res, res.Data...)
+	}
+}
@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/paulmach/orb"
+)
+
+func cityCmds(parent *kingpin.CmdClause, cmds map[string]sysadminCmd) {
+	create := parent.Command("create", "create a city")
+	createCountry := create.Flag("country", "country ISO3 code").Required().String()
+	createName := create.Flag("name", "city name").Required().String()
+	createTimezone := create.Flag("timezone", "IANA timezone").Required().String()
+	createLon := create.Flag("lon", "longitude of the city center").Required().Float64()
+	createLat := create.Flag("lat", "latitude of the city center").Required().Float64()
+	createStatus := create.Flag("status", "initial city status").Default(enum.CityStatusSupported).String()
+
+	cmds[create.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		res, err := d.city.Create(ctx, city.CreateParams{
+			CountryID: *createCountry,
+			Name:      *createName,
+			Timezone:  *createTimezone,
+			Status:    *createStatus,
+			Point:     orb.Point{*createLon, *createLat},
+		})
+		if err != nil {
+			return err
+		}
+
+		return out.cities(res, res)
+	}
+
+	update := parent.Command("update", "update a city, only the given flags are changed")
+	updateID := update.Arg("city_id", "city id").Required().String()
+	updateName := update.Flag("name", "city name").String()
+	updateSlug := update.Flag("slug", "city slug").String()
+	updateIcon := update.Flag("icon", "city icon url").String()
+	updateTimezone := update.Flag("timezone", "IANA timezone").String()
+	updateLon := update.Flag("lon", "longitude of the city center, requires --lat").String()
+	updateLat := update.Flag("lat", "latitude of the city center, requires --lon").String()
+
+	cmds[update.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityID, err := parseUUID("city_id", *updateID)
+		if err != nil {
+			return err
+		}
+
+		params := city.UpdateParams{
+			Name:     optional(*updateName),
+			Slug:     optional(*updateSlug),
+			Icon:     optional(*updateIcon),
+			Timezone: optional(*updateTimezone),
+		}
+		if *updateLon != "" || *updateLat != "" {
+			point, err := parsePoint(*updateLon, *updateLat)
+			if err != nil {
+				return err
+			}
+			params.Point = &point
+		}
+
+		res, err := d.city.UpdateByAdmin(ctx, cityID, params)
+		if err != nil {
+			return err
+		}
+
+		return out.cities(res, res)
+	}
+
+	status := parent.Command("status", "set city status")
+	statusID := status.Arg("city_id", "city id").Required().String()
+	statusValue := status.Arg("status", "new city status").Required().Enum(enum.GetAllCityStatuses()...)
+
+	cmds[status.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityID, err := parseUUID("city_id", *statusID)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.city.UpdateStatusBySysAdmin(ctx, cityID, *statusValue)
+		if err != nil {
+			return err
+		}
+
+		return out.cities(res, res)
+	}
+
+	list := parent.Command("list", "list cities")
+	listName := list.Flag("name", "filter by name").String()
+	listStatus := list.Flag("status", "filter by status").Enum(enum.GetAllCityStatuses()...)
+	listCountry := list.Flag("country", "filter by country ISO3 code").String()
+	listPage := list.Flag("page", "page number").Default("1").Uint64()
+	listSize := list.Flag("size", "page size").Default("20").Uint64()
+
+	cmds[list.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		res, err := d.city.Filter(ctx, city.FilterParams{
+			Name:      optional(*listName),
+			Status:    optional(*listStatus),
+			CountryID: optional(*listCountry),
+		}, *listPage, *listSize)
+		if err != nil {
+			return err
+		}
+
+		return out.cities(res, res.Data...)
+	}
+}
+
+func parsePoint(lon, lat string) (orb.Point, error) {
+	if lon == "" || lat == "" {
+		return orb.Point{}, fmt.Errorf("both --lon and --lat must be set")
+	}
+
+	x, err := strconv.ParseFloat(lon, 64)
+	if err != nil {
+		return orb.Point{}, fmt.Errorf("invalid lon %q: %w", lon, err)
+	}
+	y, err := strconv.ParseFloat(lat, 64)
+	if err != nil {
+		return orb.Point{}, fmt.Errorf("invalid lat %q: %w", lat, err)
+	}
+
+	return orb.Point{x, y}, nil
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"sync"
@@ -16,39 +17,86 @@ import (
 )
 
 func Run(args []string) bool {
-	cfg := internal.LoadConfig()
-
-	log := logium.NewLogger(cfg.Log.Level, cfg.Log.Format)
-	log.Info("Starting server...")
-
 	var (
 		service = kingpin.New("chains-auth", "")
 		runCmd  = service.Command("run", "run command")
 
-		serviceCmd     = runCmd.Command("service", "run service")
-		migrateCmd     = service.Command("migrate", "migrate command")
-		migrateUpCmd   = migrateCmd.Command("up", "migrate db up")
-		migrateDownCmd = migrateCmd.Command("down", "migrate db down")
+		serviceCmd       = runCmd.Command("service", "run service")
+		migrateCmd       = service.Command("migrate", "migrate command")
+		migrateDryRun    = migrateCmd.Flag("dry-run", "print the SQL instead of applying it").Bool()
+		migrateUpCmd     = migrateCmd.Command("up", "migrate db up")
+		migrateDownCmd   = migrateCmd.Command("down", "migrate db down")
+		migrateDownSteps = migrateDownCmd.Flag("steps", "number of migrations to roll back, 0 rolls back all").Default("0").Int()
+		migrateRedoCmd   = migrateCmd.Command("redo", "roll back and reapply the last migration")
+		migrateStatusCmd = migrateCmd.Command("status", "show applied migrations")
+
+		configCmd      = service.Command("config", "config command")
+		configPrintCmd = configCmd.Command("print", "print the effective config with secrets redacted")
+
+		output = service.Flag("output", "output format of the city, admin and invite commands").
+			Short('o').Default(outputTable).Enum(outputTable, outputJSON)
 	)
 
+	sysCmds := sysadminCmds(service)
+
+	command, err := service.Parse(args[1:])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to parse arguments: %v\n", err)
+		return false
+	}
+
+	cfg, err := internal.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "failed to load config: %v\n", err)
+		return false
+	}
+
+	if command == configPrintCmd.FullCommand() {
+		if err = cfg.Print(os.Stdout); err != nil {
+			fmt.Fprintf(os.Stderr, "failed to print config: %v\n", err)
+			return false
+		}
+	}
+
+	if err = cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "invalid config:\n%v\n", err)
+		return false
+	}
+	if command == configPrintCmd.FullCommand() {
+		return true
+	}
+
+	log := logium.NewLogger(cfg.Log.Level, cfg.Log.Format)
+
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
 	var wg sync.WaitGroup
 
-	command, err := service.Parse(args[1:])
-	if err != nil {
-		log.WithError(err).Error("failed to parse arguments")
-		return false
+	if c, ok := sysCmds[command]; ok {
+		err = runSysadmin(ctx, cfg, log, c, printer{format: *output, w: os.Stdout})
+		if err != nil {
+			log.WithError(err).Errorf("failed to exec %s", command)
+			return false
+		}
+
+		return true
 	}
 
+	migrateOpts := migrations.Options{DryRun: *migrateDryRun, Out: os.Stdout}
+
 	switch command {
 	case serviceCmd.FullCommand():
+		log.Info("Starting server...")
 		cmd.StartServices(ctx, cfg, log, &wg)
 	case migrateUpCmd.FullCommand():
-		err = migrations.MigrateUp(cfg.Database.SQL.URL)
+		err = migrations.MigrateUp(cfg.Database.SQL.URL, migrateOpts)
 	case migrateDownCmd.FullCommand():
-		err = migrations.MigrateDown(cfg.Database.SQL.URL)
+		err = migrations.MigrateDown(cfg.Database.SQL.URL, *migrateDownSteps, migrateOpts)
+	case migrateRedoCmd.FullCommand():
+		err = migrations.Redo(cfg.Database.SQL.URL, migrateOpts)
+	case migrateStatusCmd.FullCommand():
+		err = migrations.Status(cfg.Database.SQL.URL, os.Stdout)
 	default:
 		log.Errorf("unknown command %s", command)
 		return false
@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/google/uuid"
+)
+
+const replayPageSize = 100
+
+// replayPublisher re-emits snapshot events, an empty topic keeps the topic of
+// the event.
+type replayPublisher interface {
+	ReplayCityCreated(ctx context.Context, topic string, city models.City) error
+	ReplayCityAdminCreated(ctx context.Context, topic string, admin models.CityAdmin, city models.City) error
+}
+
+func eventsCmds(parent *kingpin.CmdClause, cmds map[string]sysadminCmd) {
+	replay := parent.Command("replay", "re-emit city.created and city.admin.created snapshot events with a replay=true header")
+	replayCity := replay.Flag("city", "replay only these cities, repeatable").Strings()
+	replayStatus := replay.Flag("status", "replay only cities with this status").Enum(enum.GetAllCityStatuses()...)
+	replayCountry := replay.Flag("country", "replay only cities of this country ISO3 code").String()
+	replayAdmins := replay.Flag("admins", "replay city.admin.created for the current admins of the cities").Default("true").Bool()
+	replayTopic := replay.Flag("topic", "topic to publish to, defaults to the topic of each event").String()
+	replayRate := replay.Flag("rate", "maximum events per second, 0 disables the limit").Default("50").Int()
+
+	cmds[replay.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityIDs, err := parseUUIDs("city", *replayCity)
+		if err != nil {
+			return err
+		}
+		if *replayRate < 0 {
+			return fmt.Errorf("invalid rate %d: must not be negative", *replayRate)
+		}
+
+		res, err := replayEvents(ctx, d, replayParams{
+			Cities: city.FilterParams{
+				ID:        cityIDs,
+				Status:    optional(*replayStatus),
+				CountryID: optional(*replayCountry),
+			},
+			Admins: *replayAdmins,
+			Topic:  *replayTopic,
+			Rate:   *replayRate,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out.w, "replayed %d city.created and %d city.admin.created events\n", res.cities, res.admins)
+		return err
+	}
+}
+
+type replayParams struct {
+	Cities city.FilterParams
+	Admins bool
+	Topic  string
+	Rate   int
+}
+
+type replayed struct {
+	cities int
+	admins int
+}
+
+// replayEvents emits a city.created event for every matching city followed by
+// a city.admin.created event for each of its current admins. The cities are
+// read up front so that publishing does not shift the pages.
+func replayEvents(ctx context.Context, d domain, params replayParams) (replayed, error) {
+	var res replayed
+
+	cities, err := replayCities(ctx, d, params.Cities)
+	if err != nil {
+		return res, err
+	}
+
+	wait, stop := rateLimit(ctx, params.Rate)
+	defer stop()
+
+	for _, c := range cities {
+		if err = wait(); err != nil {
+			return res, err
+		}
+		if err = d.events.ReplayCityCreated(ctx, params.Topic, c); err != nil {
+			return res, fmt.Errorf("replay city %s: %w", c.ID, err)
+		}
+		res.cities++
+
+		if !params.Admins {
+			continue
+		}
+
+		for page := uint64(1); ; page++ {
+			admins, err := d.admin.Filter(ctx, admin.FilterParams{CityID: []uuid.UUID{c.ID}}, page, replayPageSize)
+			if err != nil {
+				return res, err
+			}
+
+			for _, a := range admins.Data {
+				if err = wait(); err != nil {
+					return res, err
+				}
+				if err = d.events.ReplayCityAdminCreated(ctx, params.Topic, a, c); err != nil {
+					return res, fmt.Errorf("replay city admin %s of city %s: %w", a.UserID, c.ID, err)
+				}
+				res.admins++
+			}
+
+			if lastPage(page, uint64(len(admins.Data)), admins.Total) {
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+func replayCities(ctx context.Context, d domain, filters city.FilterParams) ([]models.City, error) {
+	var cities []models.City
+	seen := make(map[uuid.UUID]bool)
+
+	for page := uint64(1); ; page++ {
+		res, err := d.city.Filter(ctx, filters, page, replayPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, c := range res.Data {
+			if !seen[c.ID] {
+				seen[c.ID] = true
+				cities = append(cities, c)
+			}
+		}
+
+		if lastPage(page, uint64(len(res.Data)), res.Total) {
+			return cities, nil
+		}
+	}
+}
+
+func lastPage(page, got, total uint64) bool {
+	return got < replayPageSize || page*replayPageSize >= total
+}
+
+// rateLimit returns a func which blocks until the next of perSecond events may
+// be sent, zero means no limit.
+func rateLimit(ctx context.Context, perSecond int) (wait func() error, stop func()) {
+	if perSecond == 0 {
+		return ctx.Err, func() {}
+	}
+
+	ticker := time.NewTicker(time.Second / time.Duration(perSecond))
+	first := true
+
+	return func() error {
+		if first {
+			first = false
+			return ctx.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			return nil
+		}
+	}, ticker.Stop
+}
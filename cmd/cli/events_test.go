@@ -0,0 +1,154 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/google/uuid"
+)
+
+type replayRecorder struct {
+	topics []string
+	cities []uuid.UUID
+	admins []uuid.UUID
+	err    error
+}
+
+func (r *replayRecorder) ReplayCityCreated(_ context.Context, topic string, c models.City) error {
+	r.topics = append(r.topics, topic)
+	r.cities = append(r.cities, c.ID)
+	return r.err
+}
+
+func (r *replayRecorder) ReplayCityAdminCreated(_ context.Context, topic string, a models.CityAdmin, c models.City) error {
+	if a.CityID != c.ID {
+		return errors.New("admin replayed with another city")
+	}
+	r.topics = append(r.topics, topic)
+	r.admins = append(r.admins, a.UserID)
+	return r.err
+}
+
+func TestReplayEvents(t *testing.T) {
+	ctx := context.Background()
+	db := memory.New()
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	// more cities than fit on a page, so paging is covered
+	var last models.City
+	for i := range replayPageSize + 5 {
+		c, err := db.CreateCity(ctx, models.City{
+			ID:        uuid.New(),
+			CountryID: "UKR",
+			Status:    enum.CityStatusSupported,
+			Name:      "city",
+			Timezone:  "Europe/Kyiv",
+			CreatedAt: ts.Add(time.Duration(i) * time.Second),
+			UpdatedAt: ts,
+		})
+		if err != nil {
+			t.Fatalf("create city: %v", err)
+		}
+		last = c
+	}
+	warsaw, err := db.CreateCity(ctx, models.City{
+		ID:        uuid.New(),
+		CountryID: "POL",
+		Status:    enum.CityStatusSupported,
+		Name:      "Warsaw",
+		Timezone:  "Europe/Warsaw",
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	})
+	if err != nil {
+		t.Fatalf("create city: %v", err)
+	}
+
+	for _, role := range []string{enum.CityAdminRoleChief, enum.CityAdminRoleModerator} {
+		err = db.CreateCityAdmin(ctx, models.CityAdmin{
+			UserID:    uuid.New(),
+			CityID:    last.ID,
+			Role:      role,
+			CreatedAt: ts,
+			UpdatedAt: ts,
+		})
+		if err != nil {
+			t.Fatalf("create admin: %v", err)
+		}
+	}
+
+	newDomain := func(rec *replayRecorder) domain {
+		return domain{
+			city:   city.NewService(db, nil),
+			admin:  admin.NewService(db, nil),
+			events: rec,
+		}
+	}
+
+	t.Run("all", func(t *testing.T) {
+		rec := &replayRecorder{}
+		res, err := replayEvents(ctx, newDomain(rec), replayParams{Admins: true, Topic: "replay"})
+		if err != nil {
+			t.Fatalf("replay: %v", err)
+		}
+
+		if res.cities != replayPageSize+6 || len(rec.cities) != res.cities {
+			t.Errorf("replayed %d cities, recorded %d, want %d", res.cities, len(rec.cities), replayPageSize+6)
+		}
+		if res.admins != 2 || len(rec.admins) != 2 {
+			t.Errorf("replayed %d admins, recorded %d, want 2", res.admins, len(rec.admins))
+		}
+		for _, topic := range rec.topics {
+			if topic != "replay" {
+				t.Fatalf("published to %q, want replay", topic)
+			}
+		}
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		country := "POL"
+		rec := &replayRecorder{}
+		res, err := replayEvents(ctx, newDomain(rec), replayParams{
+			Cities: city.FilterParams{CountryID: &country},
+			Admins: true,
+			Rate:   1000,
+		})
+		if err != nil {
+			t.Fatalf("replay: %v", err)
+		}
+
+		if res.cities != 1 || rec.cities[0] != warsaw.ID || res.admins != 0 {
+			t.Errorf("replayed %+v of cities %v, want only %s", res, rec.cities, warsaw.ID)
+		}
+	})
+
+	t.Run("without admins", func(t *testing.T) {
+		rec := &replayRecorder{}
+		res, err := replayEvents(ctx, newDomain(rec), replayParams{Cities: city.FilterParams{ID: []uuid.UUID{last.ID}}})
+		if err != nil {
+			t.Fatalf("replay: %v", err)
+		}
+
+		if res.cities != 1 || res.admins != 0 {
+			t.Errorf("replayed %+v, want one city and no admins", res)
+		}
+	})
+
+	t.Run("publish error", func(t *testing.T) {
+		rec := &replayRecorder{err: errors.New("broker down")}
+		res, err := replayEvents(ctx, newDomain(rec), replayParams{Admins: true})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+		if res.cities != 0 || len(rec.cities) != 1 {
+			t.Errorf("replay went on after an error: %+v", res)
+		}
+	})
+}
@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+)
+
+func inviteCmds(parent *kingpin.CmdClause, cmds map[string]sysadminCmd) {
+	create := parent.Command("create", "invite a user to become a city admin")
+	createCity := create.Arg("city_id", "city id").Required().String()
+	createUser := create.Arg("user_id", "invited user id").Required().String()
+	createRole := create.Flag("role", "city admin role").Required().Enum(enum.GetAllCityAdminRoles()...)
+	createInitiator := create.Flag("initiator", "user id of the system admin sending the invite").Required().String()
+	createTTL := create.Flag("ttl", "invite lifetime").Default("24h").Duration()
+
+	cmds[create.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		cityID, err := parseUUID("city_id", *createCity)
+		if err != nil {
+			return err
+		}
+		userID, err := parseUUID("user_id", *createUser)
+		if err != nil {
+			return err
+		}
+		initiatorID, err := parseUUID("initiator", *createInitiator)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.invite.CreateBySysAdmin(ctx, initiatorID, invite.CreateParams{
+			UserID:   userID,
+			CityID:   cityID,
+			Role:     *createRole,
+			Duration: *createTTL,
+		})
+		if err != nil {
+			return err
+		}
+
+		return out.invites(res, res)
+	}
+
+	revoke := parent.Command("revoke", "revoke an invite which has not been answered yet")
+	revokeID := revoke.Arg("invite_id", "invite id").Required().String()
+
+	cmds[revoke.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		inviteID, err := parseUUID("invite_id", *revokeID)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.invite.Revoke(ctx, inviteID)
+		if err != nil {
+			return err
+		}
+
+		return out.invites(res, res)
+	}
+}
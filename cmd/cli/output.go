@@ -0,0 +1,139 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type printer struct {
+	format string
+	w      io.Writer
+}
+
+// print writes v as indented JSON or as a table built from header and rows.
+func (p printer) print(v any, header []string, rows ...[]string) error {
+	if p.format == outputJSON {
+		enc := json.NewEncoder(p.w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	tw := tabwriter.NewWriter(p.w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	return tw.Flush()
+}
+
+func (p printer) cities(v any, cities ...models.City) error {
+	rows := make([][]string, 0, len(cities))
+	for _, c := range cities {
+		rows = append(rows, []string{
+			c.ID.String(),
+			deref(c.Slug),
+			c.Name,
+			c.CountryID,
+			c.Status,
+			c.Timezone,
+			fmt.Sprintf("%g,%g", c.Point.Lon(), c.Point.Lat()),
+		})
+	}
+
+	return p.print(v, []string{"ID", "SLUG", "NAME", "COUNTRY", "STATUS", "TIMEZONE", "POINT"}, rows...)
+}
+
+func (p printer) admins(v any, admins ...models.CityAdmin) error {
+	rows := make([][]string, 0, len(admins))
+	for _, a := range admins {
+		rows = append(rows, []string{
+			a.UserID.String(),
+			a.CityID.String(),
+			a.Role,
+			deref(a.Label),
+			deref(a.Position),
+			a.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return p.print(v, []string{"USER_ID", "CITY_ID", "ROLE", "LABEL", "POSITION", "CREATED_AT"}, rows...)
+}
+
+func (p printer) invites(v any, invites ...models.Invite) error {
+	rows := make([][]string, 0, len(invites))
+	for _, i := range invites {
+		rows = append(rows, []string{
+			i.ID.String(),
+			i.CityID.String(),
+			i.UserID.String(),
+			i.Role,
+			i.Status,
+			i.ExpiresAt.Format(time.RFC3339),
+		})
+	}
+
+	return p.print(v, []string{"ID", "CITY_ID", "USER_ID", "ROLE", "STATUS", "EXPIRES_AT"}, rows...)
+}
+
+func (p printer) webhooks(v any, webhooks ...models.Webhook) error {
+	rows := make([][]string, 0, len(webhooks))
+	for _, w := range webhooks {
+		events := "*"
+		if len(w.EventTypes) > 0 {
+			events = strings.Join(w.EventTypes, ",")
+		}
+
+		rows = append(rows, []string{
+			w.ID.String(),
+			w.URL,
+			events,
+			w.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	return p.print(v, []string{"ID", "URL", "EVENTS", "CREATED_AT"}, rows...)
+}
+
+func (p printer) deliveries(v any, deliveries ...models.WebhookDelivery) error {
+	rows := make([][]string, 0, len(deliveries))
+	for _, d := range deliveries {
+		code := "-"
+		if d.ResponseCode != nil {
+			code = strconv.Itoa(*d.ResponseCode)
+		}
+
+		rows = append(rows, []string{
+			d.ID.String(),
+			d.WebhookID.String(),
+			d.EventType,
+			d.Status,
+			strconv.Itoa(d.Attempts),
+			code,
+			deref(d.LastError),
+			d.NextAttemptAt.Format(time.RFC3339),
+		})
+	}
+
+	return p.print(v, []string{"ID", "WEBHOOK_ID", "EVENT", "STATUS", "ATTEMPTS", "CODE", "LAST_ERROR", "NEXT_ATTEMPT_AT"}, rows...)
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return "-"
+	}
+
+	return *s
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/cmd"
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/chains-lab/cities-svc/internal/events/publisher"
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/chains-lab/cities-svc/internal/repo"
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+)
+
+// domain holds the services used by the sysadmin commands, they are called
+// directly against the configured database and Kafka, bypassing REST and JWT.
+type domain struct {
+	city    city.Service
+	admin   admin.Service
+	invite  invite.Service
+	webhook webhook.Service
+	events  replayPublisher
+}
+
+func newDomain(ctx context.Context, cfg internal.Config, log logium.Logger) (domain, func(), error) {
+	pg, replicas, err := cmd.OpenDatabases(ctx, cfg, log)
+	if err != nil {
+		return domain{}, nil, err
+	}
+	closeDB := func() {
+		_ = pg.Close()
+		for _, r := range replicas {
+			_ = r.Close()
+		}
+	}
+
+	database := repo.NewDatabaseWithReplicas(pg, replicas)
+	webhookSvc := webhook.NewService(database, cmd.WebhookRetry(cfg))
+	var webhookSink publisher.Webhooks
+	if cfg.Webhooks.Enabled {
+		webhookSink = webhookSvc
+	}
+
+	// Dashboards are streamed from the service process, there is nobody to
+	// broadcast to from here.
+	eventPublish := publisher.New(cfg.Kafka.Broker, cfg.Kafka.Format, webhookSink, nil)
+	profileClient := profiles.New(cfg.Profile.Url, cfg.Profile.Timeout, cfg.Profile.Retries, cfg.Profile.CacheTTL)
+
+	return domain{
+		city:    city.NewService(database, eventPublish),
+		admin:   admin.NewService(database, eventPublish),
+		invite:  invite.NewService(database, eventPublish, profileClient),
+		webhook: webhookSvc,
+		events:  eventPublish,
+	}, closeDB, nil
+}
+
+type sysadminCmd func(ctx context.Context, d domain, out printer) error
+
+// sysadminCmds registers the city, admin, invite, events and webhook commands on the app and
+// returns them keyed by their full command name.
+func sysadminCmds(app *kingpin.Application) map[string]sysadminCmd {
+	cmds := make(map[string]sysadminCmd)
+
+	cityCmds(app.Command("city", "manage cities"), cmds)
+	adminCmds(app.Command("admin", "manage city admins"), cmds)
+	inviteCmds(app.Command("invite", "manage city admin invites"), cmds)
+	eventsCmds(app.Command("events", "manage published events"), cmds)
+	webhookCmds(app.Command("webhook", "manage webhook subscriptions and deliveries"), cmds)
+
+	return cmds
+}
+
+func runSysadmin(
+	ctx context.Context,
+	cfg internal.Config,
+	log logium.Logger,
+	c sysadminCmd,
+	out printer,
+) error {
+	d, closeDB, err := newDomain(ctx, cfg, log)
+	if err != nil {
+		return err
+	}
+	defer closeDB()
+
+	return c(ctx, d, out)
+}
+
+func parseUUID(name, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("invalid %s %q: %w", name, value, err)
+	}
+
+	return id, nil
+}
+
+func parseUUIDs(name string, values []string) ([]uuid.UUID, error) {
+	ids := make([]uuid.UUID, 0, len(values))
+	for _, v := range values {
+		id, err := parseUUID(name, v)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
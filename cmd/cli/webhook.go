@@ -0,0 +1,137 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alecthomas/kingpin"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+)
+
+func webhookCmds(parent *kingpin.CmdClause, cmds map[string]sysadminCmd) {
+	eventTypes := make([]string, 0, len(contracts.Events))
+	for _, e := range contracts.Events {
+		eventTypes = append(eventTypes, e.Name)
+	}
+
+	create := parent.Command("create", "register a webhook endpoint, the secret is only printed here")
+	createURL := create.Arg("url", "endpoint url").Required().String()
+	createEvents := create.Flag("event", "event type to deliver, repeatable, all events when not set").Enums(eventTypes...)
+	createSecret := create.Flag("secret", "signing secret, generated when not set").String()
+
+	cmds[create.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		res, err := d.webhook.Create(ctx, webhook.CreateParams{
+			URL:        *createURL,
+			Secret:     *createSecret,
+			EventTypes: *createEvents,
+		})
+		if err != nil {
+			return err
+		}
+
+		if out.format == outputJSON {
+			return out.print(struct {
+				models.Webhook
+				Secret string `json:"secret"`
+			}{res, res.Secret}, nil)
+		}
+		if err = out.webhooks(res, res); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out.w, "secret: %s\n", res.Secret)
+		return err
+	}
+
+	list := parent.Command("list", "list webhooks")
+
+	cmds[list.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		res, err := d.webhook.List(ctx)
+		if err != nil {
+			return err
+		}
+
+		return out.webhooks(res, res...)
+	}
+
+	del := parent.Command("delete", "delete a webhook and its deliveries")
+	delID := del.Arg("webhook_id", "webhook id").Required().String()
+
+	cmds[del.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		webhookID, err := parseUUID("webhook_id", *delID)
+		if err != nil {
+			return err
+		}
+
+		if err = d.webhook.Delete(ctx, webhookID); err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintf(out.w, "webhook %s deleted\n", webhookID)
+		return err
+	}
+
+	deliveries := parent.Command("deliveries", "list webhook deliveries, newest first")
+	deliveriesWebhook := deliveries.Flag("webhook", "filter by webhook id, repeatable").Strings()
+	deliveriesStatus := deliveries.Flag("status", "filter by status, repeatable").Enums(enum.GetAllWebhookDeliveryStatuses()...)
+	deliveriesPage := deliveries.Flag("page", "page number").Default("1").Uint64()
+	deliveriesSize := deliveries.Flag("size", "page size").Default("20").Uint64()
+
+	cmds[deliveries.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		return listDeliveries(ctx, d, out, *deliveriesWebhook, *deliveriesStatus, *deliveriesPage, *deliveriesSize)
+	}
+
+	dead := parent.Command("dead-letters", "list deliveries which ran out of attempts, newest first")
+	deadWebhook := dead.Flag("webhook", "filter by webhook id, repeatable").Strings()
+	deadPage := dead.Flag("page", "page number").Default("1").Uint64()
+	deadSize := dead.Flag("size", "page size").Default("20").Uint64()
+
+	cmds[dead.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		return listDeliveries(ctx, d, out, *deadWebhook, []string{enum.WebhookDeliveryStatusDead}, *deadPage, *deadSize)
+	}
+
+	redeliver := parent.Command("redeliver", "send a dead delivery again with a fresh set of attempts")
+	redeliverID := redeliver.Arg("delivery_id", "webhook delivery id").Required().String()
+
+	cmds[redeliver.FullCommand()] = func(ctx context.Context, d domain, out printer) error {
+		deliveryID, err := parseUUID("delivery_id", *redeliverID)
+		if err != nil {
+			return err
+		}
+
+		res, err := d.webhook.Redeliver(ctx, deliveryID)
+		if err != nil {
+			return err
+		}
+
+		return out.deliveries(res, res)
+	}
+}
+
+func listDeliveries(
+	ctx context.Context,
+	d domain,
+	out printer,
+	webhookIDs, statuses []string,
+	page, size uint64,
+) error {
+	ids, err := parseUUIDs("webhook", webhookIDs)
+	if err != nil {
+		return err
+	}
+
+	filters := webhook.FilterDeliveriesParams{Status: statuses}
+	if len(ids) > 0 {
+		filters.WebhookID = ids
+	}
+
+	res, err := d.webhook.FilterDeliveries(ctx, filters, page, size)
+	if err != nil {
+		return err
+	}
+
+	return out.deliveries(res, res.Data...)
+}
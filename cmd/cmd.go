@@ -2,14 +2,28 @@ package cmd
 
 import (
 	"context"
-	"database/sql"
+	"fmt"
 	"sync"
+	"time"
 
+	"github.com/chains-lab/cities-svc/cmd/migrations"
+	"github.com/chains-lab/cities-svc/docs"
 	"github.com/chains-lab/cities-svc/internal"
 	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
 	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/domain/services/idempotency"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
 	"github.com/chains-lab/cities-svc/internal/events/publisher"
+	"github.com/chains-lab/cities-svc/internal/health"
+	"github.com/chains-lab/cities-svc/internal/metrics"
+	"github.com/chains-lab/cities-svc/internal/profiles"
 	"github.com/chains-lab/cities-svc/internal/repo"
+	"github.com/chains-lab/cities-svc/internal/rpc"
+	"github.com/chains-lab/cities-svc/internal/rpc/handlers"
+	"github.com/chains-lab/cities-svc/internal/stream"
+	"github.com/chains-lab/cities-svc/internal/swagger"
+	"github.com/chains-lab/cities-svc/internal/tracing"
+	"github.com/chains-lab/cities-svc/internal/webhooks"
 
 	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
 	"github.com/chains-lab/cities-svc/internal/rest"
@@ -28,21 +42,116 @@ func StartServices(ctx context.Context, cfg internal.Config, log logium.Logger,
 		}()
 	}
 
-	pg, err := sql.Open("postgres", cfg.Database.SQL.URL)
+	pg, replicas, err := OpenDatabases(ctx, cfg, log)
 	if err != nil {
 		log.Fatal("failed to connect to database", "error", err)
 	}
 
-	database := repo.NewDatabase(pg)
+	shutdownTracing, err := tracing.Setup(ctx, cfg)
+	if err != nil {
+		log.Fatal("failed to setup tracing", "error", err)
+	}
+	run(func() {
+		<-ctx.Done()
+
+		shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(shCtx); err != nil {
+			log.Errorf("tracing shutdown error: %v", err)
+		}
+	})
+
+	mtr := metrics.New()
+	mtr.RegisterDBStats(pg, "postgres")
+	for i, replica := range replicas {
+		mtr.RegisterDBStats(replica, fmt.Sprintf("postgres_replica_%d", i))
+	}
+
+	trc := tracing.NewHooks()
+
+	database := repo.NewDatabaseWithReplicas(pg, replicas, mtr, trc)
+
+	webhookSvc := webhook.NewService(database, WebhookRetry(cfg))
+	var webhookSink publisher.Webhooks
+	if cfg.Webhooks.Enabled {
+		webhookSink = webhookSvc
+	}
+
+	cityEvents := stream.NewBroadcaster(cfg.Rest.Events.Buffer)
 
-	eventPublish := publisher.New(cfg.Kafka.Broker)
+	eventPublish := publisher.New(cfg.Kafka.Broker, cfg.Kafka.Format, webhookSink, cityEvents, mtr, trc)
 
 	citySvc := city.NewService(database, eventPublish)
 	cityAdminSvc := admin.NewService(database, eventPublish)
-	inviteSvc := invite.NewService(database, eventPublish)
+	profileClient := profiles.New(cfg.Profile.Url, cfg.Profile.Timeout, cfg.Profile.Retries, cfg.Profile.CacheTTL)
+
+	inviteSvc := invite.NewService(database, eventPublish, profileClient)
+
+	ctrl := controller.New(log, citySvc, cityAdminSvc, inviteSvc, profileClient, cityEvents, cfg.Rest.Events.Heartbeat)
+	spec, err := docs.Router()
+	if err != nil {
+		log.Fatal("failed to load openapi spec", "error", err)
+	}
+
+	idempotencySvc := idempotency.NewService(database, cfg.Idempotency.TTL, cfg.Idempotency.Lease)
+	mdlv := middlewares.New(log, mtr, spec, cfg.RateLimit, idempotencySvc)
+
+	probes := health.New(cfg.Health.Timeout,
+		health.Postgres(pg),
+		health.Check{
+			Name: "migrations",
+			Fn: func(ctx context.Context) error {
+				return migrations.CheckVersion(ctx, pg)
+			},
+		},
+		health.Kafka(cfg.Kafka.Broker),
+	)
 
-	ctrl := controller.New(log, citySvc, cityAdminSvc, inviteSvc)
-	mdlv := middlewares.New(log)
+	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl, probes) })
+	run(func() { purgeIdempotencyKeys(ctx, log, idempotencySvc, cfg.Idempotency.PurgeInterval) })
 
-	run(func() { rest.Run(ctx, cfg, log, mdlv, ctrl) })
+	if cfg.GRPC.Enabled {
+		rpcHandlers := handlers.New(log, citySvc, cityAdminSvc, inviteSvc)
+		run(func() { rpc.Run(ctx, cfg, log, rpcHandlers) })
+	}
+
+	if cfg.Metrics.Enabled {
+		run(func() { metrics.Run(ctx, cfg, log, mtr) })
+	}
+
+	if cfg.Swagger.Enabled {
+		run(func() { swagger.Run(ctx, cfg, log) })
+	}
+
+	if cfg.Webhooks.Enabled {
+		dispatcher := webhooks.NewDispatcher(webhookSvc, log, cfg.Webhooks.Timeout, cfg.Webhooks.BatchSize)
+		run(func() { webhooks.Run(ctx, cfg, log, dispatcher) })
+	}
+}
+
+// purgeIdempotencyKeys deletes the expired idempotency keys every interval.
+func purgeIdempotencyKeys(ctx context.Context, log logium.Logger, svc idempotency.Service, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := svc.Purge(ctx); err != nil {
+			log.WithError(err).Error("failed to purge idempotency keys")
+		}
+	}
+}
+
+// WebhookRetry is the delivery schedule of webhooks from the config.
+func WebhookRetry(cfg internal.Config) webhook.Retry {
+	return webhook.Retry{
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		Backoff:     cfg.Webhooks.Backoff,
+		MaxBackoff:  cfg.Webhooks.MaxBackoff,
+	}
 }
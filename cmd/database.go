@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/logium"
+)
+
+// OpenDatabases opens the primary database and its read replicas with the
+// configured pool settings, each of them has to answer a ping before use.
+func OpenDatabases(ctx context.Context, cfg internal.Config, log logium.Logger) (*sql.DB, []*sql.DB, error) {
+	primary, err := openDatabase(ctx, cfg, log, "primary", cfg.Database.SQL.URL)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	replicas := make([]*sql.DB, 0, len(cfg.Database.SQL.ReplicaURLs))
+	for i, url := range cfg.Database.SQL.ReplicaURLs {
+		db, err := openDatabase(ctx, cfg, log, fmt.Sprintf("replica %d", i), url)
+		if err != nil {
+			for _, r := range replicas {
+				_ = r.Close()
+			}
+			_ = primary.Close()
+			return nil, nil, err
+		}
+		replicas = append(replicas, db)
+	}
+
+	return primary, replicas, nil
+}
+
+func openDatabase(ctx context.Context, cfg internal.Config, log logium.Logger, name, url string) (*sql.DB, error) {
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		return nil, fmt.Errorf("open %s database: %w", name, err)
+	}
+
+	pool := cfg.Database.SQL.Pool
+	db.SetMaxOpenConns(pool.MaxOpenConns)
+	db.SetMaxIdleConns(pool.MaxIdleConns)
+	db.SetConnMaxLifetime(pool.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(pool.ConnMaxIdleTime)
+
+	ping := cfg.Database.SQL.Ping
+	for attempt := 0; ; attempt++ {
+		err = pingDatabase(ctx, db, ping.Timeout)
+		if err == nil {
+			return db, nil
+		}
+		if attempt >= ping.Retries {
+			_ = db.Close()
+			return nil, fmt.Errorf("ping %s database after %d attempts: %w", name, attempt+1, err)
+		}
+
+		wait := pingBackoff(ping.Backoff, ping.MaxBackoff, attempt)
+		log.WithError(err).Errorf("failed to ping %s database, retrying in %s", name, wait)
+
+		select {
+		case <-ctx.Done():
+			_ = db.Close()
+			return nil, ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// pingBackoff is the delay after the given failed attempt, counted from zero,
+// doubled every attempt and capped at maxBackoff.
+func pingBackoff(backoff, maxBackoff time.Duration, attempt int) time.Duration {
+	d := backoff
+	for i := 0; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, maxBackoff)
+}
+
+func pingDatabase(ctx context.Context, db *sql.DB, timeout time.Duration) error {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return db.PingContext(ctx)
+}
@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPingBackoff(t *testing.T) {
+	for attempt, want := range []time.Duration{
+		500 * time.Millisecond,
+		time.Second,
+		2 * time.Second,
+		4 * time.Second,
+		8 * time.Second,
+		10 * time.Second,
+		10 * time.Second,
+	} {
+		if got := pingBackoff(500*time.Millisecond, 10*time.Second, attempt); got != want {
+			t.Errorf("attempt %d: backoff = %s, want %s", attempt, got, want)
+		}
+	}
+
+	// a shift this large would overflow
+	if got := pingBackoff(500*time.Millisecond, 10*time.Second, 1000); got != 10*time.Second {
+		t.Errorf("attempt 1000: backoff = %s, want the cap", got)
+	}
+}
@@ -1,8 +1,14 @@
 package migrations
 
 import (
+	"context"
 	"database/sql"
 	"embed"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
 
 	"github.com/pkg/errors"
 	migrate "github.com/rubenv/sql-migrate"
@@ -12,29 +18,178 @@ import (
 //go:embed schema/*.sql
 var Migrations embed.FS
 
+// migrationsTable is where sql-migrate records applied migrations.
+const migrationsTable = "gorp_migrations"
+
 var migrations = &migrate.EmbedFileSystemMigrationSource{
 	FileSystem: Migrations,
 	Root:       "schema",
 }
 
-func MigrateUp(dbUrl string) error {
+// Options controls how migrations are executed. With DryRun set the planned
+// SQL is written to Out instead of being applied.
+type Options struct {
+	DryRun bool
+	Out    io.Writer
+}
+
+func MigrateUp(dbUrl string, opts Options) error {
 	db, err := sql.Open("postgres", dbUrl)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer db.Close()
+
+	return exec(db, migrate.Up, 0, opts)
+}
 
-	applied, err := migrate.Exec(db, "postgres", migrations, migrate.Up)
+// MigrateDown rolls back the given number of migrations, all of them if steps is 0.
+func MigrateDown(dbUrl string, steps int, opts Options) error {
+	db, err := sql.Open("postgres", dbUrl)
 	if err != nil {
-		return errors.Wrap(err, "failed to apply migrations")
+		return errors.Wrap(err, "failed to open database")
 	}
-	logrus.WithField("applied", applied).Info("migrations applied")
-	return nil
+	defer db.Close()
+
+	return exec(db, migrate.Down, steps, opts)
+}
+
+// Redo rolls back the last applied migration and applies it again.
+func Redo(dbUrl string, opts Options) error {
+	db, err := sql.Open("postgres", dbUrl)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer db.Close()
+
+	planned, _, err := migrate.PlanMigration(db, "postgres", migrations, migrate.Down, 1)
+	if err != nil {
+		return errors.Wrap(err, "failed to plan migrations")
+	}
+	if len(planned) == 0 {
+		return errors.New("no applied migrations to redo")
+	}
+
+	if opts.DryRun {
+		m := planned[0].Migration
+		printQueries(opts.Out, m.Id, migrate.Down, m.Down)
+		printQueries(opts.Out, m.Id, migrate.Up, m.Up)
+		return nil
+	}
+
+	if err = exec(db, migrate.Down, 1, opts); err != nil {
+		return err
+	}
+
+	return exec(db, migrate.Up, 1, opts)
 }
 
-func MigrateDown(dbUrl string) error {
+// Status writes every embedded migration with the time it was applied at.
+func Status(dbUrl string, out io.Writer) error {
 	db, err := sql.Open("postgres", dbUrl)
+	if err != nil {
+		return errors.Wrap(err, "failed to open database")
+	}
+	defer db.Close()
+
+	expected, err := migrations.FindMigrations()
+	if err != nil {
+		return errors.Wrap(err, "failed to read embedded migrations")
+	}
+
+	records, err := migrate.GetMigrationRecords(db, "postgres")
+	if err != nil {
+		return errors.Wrap(err, "failed to read applied migrations")
+	}
+
+	applied := make(map[string]time.Time, len(records))
+	for _, rec := range records {
+		applied[rec.Id] = rec.AppliedAt
+	}
+
+	tw := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "MIGRATION\tAPPLIED")
+	for _, m := range expected {
+		at, ok := applied[m.Id]
+		if !ok {
+			fmt.Fprintf(tw, "%s\tno\n", m.Id)
+			continue
+		}
+		fmt.Fprintf(tw, "%s\t%s\n", m.Id, at.UTC().Format(time.RFC3339))
+	}
+
+	return tw.Flush()
+}
 
-	applied, err := migrate.Exec(db, "postgres", migrations, migrate.Down)
+func exec(db *sql.DB, dir migrate.MigrationDirection, max int, opts Options) error {
+	if opts.DryRun {
+		planned, _, err := migrate.PlanMigration(db, "postgres", migrations, dir, max)
+		if err != nil {
+			return errors.Wrap(err, "failed to plan migrations")
+		}
+
+		for _, m := range planned {
+			printQueries(opts.Out, m.Id, dir, m.Queries)
+		}
+
+		return nil
+	}
+
+	applied, err := migrate.ExecMax(db, "postgres", migrations, dir, max)
 	if err != nil {
 		return errors.Wrap(err, "failed to apply migrations")
 	}
 	logrus.WithField("applied", applied).Info("migrations applied")
+
+	return nil
+}
+
+func printQueries(out io.Writer, id string, dir migrate.MigrationDirection, queries []string) {
+	direction := "up"
+	if dir == migrate.Down {
+		direction = "down"
+	}
+
+	fmt.Fprintf(out, "-- %s (%s)\n", id, direction)
+	for _, q := range queries {
+		fmt.Fprintln(out, strings.TrimSpace(q))
+	}
+	fmt.Fprintln(out)
+}
+
+// CheckVersion reports an error unless every embedded migration has been
+// applied, i.e. the database schema is at the version this binary expects.
+func CheckVersion(ctx context.Context, db *sql.DB) error {
+	expected, err := migrations.FindMigrations()
+	if err != nil {
+		return errors.Wrap(err, "failed to read embedded migrations")
+	}
+
+	// sql-migrate has no context aware way to read the records, the readiness
+	// probe must not outlive its timeout on a stuck query.
+	rows, err := db.QueryContext(ctx, "SELECT id FROM "+migrationsTable)
+	if err != nil {
+		return errors.Wrap(err, "failed to read applied migrations")
+	}
+	defer rows.Close()
+
+	applied := make(map[string]bool, len(expected))
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			return errors.Wrap(err, "failed to scan applied migration")
+		}
+		applied[id] = true
+	}
+	if err = rows.Err(); err != nil {
+		return errors.Wrap(err, "failed to read applied migrations")
+	}
+
+	for _, m := range expected {
+		if !applied[m.Id] {
+			return errors.Errorf("migration %s is not applied", m.Id)
+		}
+	}
+
 	return nil
 }
@@ -0,0 +1,108 @@
+package migrations_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"os"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/cmd/migrations"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	migrate "github.com/rubenv/sql-migrate"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
+
+var source = &migrate.EmbedFileSystemMigrationSource{
+	FileSystem: migrations.Migrations,
+	Root:       "schema",
+}
+
+var trailingComma = regexp.MustCompile(`,\s*\)`)
+
+func TestMigrationsParse(t *testing.T) {
+	ms, err := source.FindMigrations()
+	if err != nil {
+		t.Fatalf("find migrations: %v", err)
+	}
+	if len(ms) == 0 {
+		t.Fatal("no embedded migrations")
+	}
+
+	for _, m := range ms {
+		if len(m.Up) == 0 || len(m.Down) == 0 {
+			t.Errorf("%s: both up and down sections are required", m.Id)
+		}
+
+		for _, q := range append(m.Up, m.Down...) {
+			if loc := trailingComma.FindStringIndex(q); loc != nil {
+				t.Errorf("%s: trailing comma near %q", m.Id, q[max(0, loc[0]-40):loc[1]])
+			}
+		}
+	}
+}
+
+// TestMigrationsUpDown applies every migration up, down and up again against
+// the disposable database of the package.
+func TestMigrationsUpDown(t *testing.T) {
+	url := pgtest.URL(t)
+
+	db, err := sql.Open("postgres", url)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	defer db.Close()
+
+	ms, err := source.FindMigrations()
+	if err != nil {
+		t.Fatalf("find migrations: %v", err)
+	}
+
+	if err = migrations.MigrateDown(url, 0, migrations.Options{}); err != nil {
+		t.Fatalf("reset: %v", err)
+	}
+
+	if err = migrations.MigrateUp(url, migrations.Options{}); err != nil {
+		t.Fatalf("up: %v", err)
+	}
+	if err = migrations.CheckVersion(context.Background(), db); err != nil {
+		t.Fatalf("check version after up: %v", err)
+	}
+
+	if err = migrations.Redo(url, migrations.Options{}); err != nil {
+		t.Fatalf("redo: %v", err)
+	}
+
+	for i := len(ms) - 1; i >= 0; i-- {
+		if err = migrations.MigrateDown(url, 1, migrations.Options{}); err != nil {
+			t.Fatalf("down %s: %v", ms[i].Id, err)
+		}
+	}
+
+	records, err := migrate.GetMigrationRecords(db, "postgres")
+	if err != nil {
+		t.Fatalf("get migration records: %v", err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("expected no applied migrations after down, got %d", len(records))
+	}
+
+	var out bytes.Buffer
+	if err = migrations.MigrateUp(url, migrations.Options{DryRun: true, Out: &out}); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	for _, m := range ms {
+		if !strings.Contains(out.String(), "-- "+m.Id+" (up)") {
+			t.Errorf("dry run output misses %s", m.Id)
+		}
+	}
+
+	if err = migrations.MigrateUp(url, migrations.Options{}); err != nil {
+		t.Fatalf("up again: %v", err)
+	}
+}
@@ -19,7 +19,7 @@ CREATE TABLE cities (
     timezone   VARCHAR(64)           NOT NULL, -- IANA tz
 
     created_at TIMESTAMP             NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
-    updated_at TIMESTAMP             NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP             NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
 );
 
 
@@ -5,12 +5,12 @@ CREATE TYPE city_admin_role AS ENUM (
     'member',
 
     'tech-lead',
-    'moderator',
+    'moderator'
 );
 
 CREATE TABLE city_administration (
     user_id    UUID      NOT NULL,
-    city_id    UUID      NOT NULL REFERENCES city(id) ON DELETE CASCADE,
+    city_id    UUID      NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
 
     position   VARCHAR(255),
     label      VARCHAR(255),
@@ -32,19 +32,21 @@ CREATE UNIQUE INDEX IF NOT EXISTS uniq_city_tech_lead
 
 CREATE TYPE invite_status AS ENUM (
     'sent',
-    'accepted'
+    'accepted',
+    'declined',
+    'revoked'
 );
 
 CREATE TABLE invites (
     id           UUID              PRIMARY KEY,
     user_id      UUID              NOT NULL,
-    city_id      UUID              NOT NULL REFERENCES city(id) ON DELETE CASCADE,
+    city_id      UUID              NOT NULL REFERENCES cities(id) ON DELETE CASCADE,
     initiator_id UUID              NOT NULL,
     status       invite_status     NOT NULL DEFAULT 'sent',
     role         city_admin_role NOT NULL,
 
     expires_at TIMESTAMP NOT NULL,
-    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
 );
 
 CREATE OR REPLACE FUNCTION check_city_can_have_admin()
@@ -0,0 +1,44 @@
+-- +migrate Up
+CREATE TABLE webhooks (
+    id          UUID          PRIMARY KEY,
+    url         VARCHAR(2048) NOT NULL,
+    secret      VARCHAR(255)  NOT NULL,
+    event_types TEXT[]        NOT NULL DEFAULT '{}',
+
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
+);
+
+CREATE TYPE webhook_delivery_status AS ENUM (
+    'pending',
+    'delivered',
+    'dead'
+);
+
+CREATE TABLE webhook_deliveries (
+    id              UUID                    PRIMARY KEY,
+    webhook_id      UUID                    NOT NULL REFERENCES webhooks(id) ON DELETE CASCADE,
+    event_id        UUID                    NOT NULL,
+    event_type      VARCHAR(255)            NOT NULL,
+    payload         JSONB                   NOT NULL,
+    status          webhook_delivery_status NOT NULL DEFAULT 'pending',
+    attempts        INTEGER                 NOT NULL DEFAULT 0,
+    response_code   INTEGER,
+    last_error      TEXT,
+    next_attempt_at TIMESTAMP               NOT NULL,
+
+    updated_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due
+    ON webhook_deliveries (next_attempt_at)
+    WHERE status = 'pending';
+
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_webhook
+    ON webhook_deliveries (webhook_id, created_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS webhook_deliveries CASCADE;
+DROP TABLE IF EXISTS webhooks CASCADE;
+
+DROP TYPE IF EXISTS webhook_delivery_status;
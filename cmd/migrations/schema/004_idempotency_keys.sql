@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE idempotency_keys (
+    user_id       UUID          NOT NULL,
+    key           VARCHAR(255)  NOT NULL,
+    fingerprint   CHAR(64)      NOT NULL,
+    status_code   INTEGER,
+    content_type  VARCHAR(255),
+    response_body BYTEA,
+    expires_at    TIMESTAMP     NOT NULL,
+
+    created_at TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC'),
+
+    PRIMARY KEY (user_id, key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at
+    ON idempotency_keys (expires_at);
+
+-- +migrate Down
+DROP TABLE IF EXISTS idempotency_keys CASCADE;
@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE webhook_deliveries
+    ADD COLUMN request_id VARCHAR(128);
+
+-- +migrate Down
+ALTER TABLE webhook_deliveries
+    DROP COLUMN IF EXISTS request_id;
@@ -0,0 +1,10 @@
+-- +migrate Up
+ALTER TABLE idempotency_keys
+    ADD COLUMN locked_until TIMESTAMP NOT NULL DEFAULT (now() AT TIME ZONE 'UTC');
+
+ALTER TABLE idempotency_keys
+    ALTER COLUMN locked_until DROP DEFAULT;
+
+-- +migrate Down
+ALTER TABLE idempotency_keys
+    DROP COLUMN IF EXISTS locked_until;
@@ -0,0 +1,30 @@
+package docs
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSpecRoutes(t *testing.T) {
+	router, err := Router()
+	if err != nil {
+		t.Fatalf("router: %v", err)
+	}
+
+	for _, tc := range []struct {
+		method, path string
+	}{
+		{"GET", "/cities-svc/v1/city/kyiv"},
+		{"GET", "/cities-svc/v1/cities"},
+		{"POST", "/cities-svc/v1/cities"},
+		{"PUT", "/cities-svc/v1/cities/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2a"},
+		{"PATCH", "/cities-svc/v1/cities/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2a/status"},
+		{"GET", "/cities-svc/v1/cities/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2a/events"},
+		{"GET", "/cities-svc/v1/cities/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2a/admins/me"},
+		{"DELETE", "/cities-svc/v1/cities/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2a/admins/7b1b1f3e-8c2a-4e1b-9a3e-0c6a3e5f1d2b"},
+	} {
+		if _, _, err = router.FindRoute(httptest.NewRequest(tc.method, tc.path, nil)); err != nil {
+			t.Errorf("%s %s: %v", tc.method, tc.path, err)
+		}
+	}
+}
@@ -1,20 +1,33 @@
 package internal
 
 import (
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"reflect"
+	"strings"
 	"time"
 
+	"github.com/go-viper/mapstructure/v2"
 	_ "github.com/lib/pq" // postgres driver don`t delete
+	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 )
 
+// EnvPrefix prefixes the env vars overriding config keys, e.g. the key
+// database.sql.url is read from CITIES_DATABASE_SQL_URL.
+const EnvPrefix = "CITIES"
+
 type ServerConfig struct {
 	Name string `mapstructure:"name"`
 }
 
 type ProfileConfig struct {
-	Url string `mapstructure:"url"`
+	Url      string        `mapstructure:"url"`
+	Timeout  time.Duration `mapstructure:"timeout"`
+	Retries  int           `mapstructure:"retries"`
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
 }
 
 type LogConfig struct {
@@ -30,28 +43,52 @@ type RestConfig struct {
 		Write      time.Duration `mapstructure:"write"`
 		Idle       time.Duration `mapstructure:"idle"`
 	} `mapstructure:"timeouts"`
+	// Events configures the server-sent events stream of city changes.
+	Events struct {
+		Buffer    int           `mapstructure:"buffer"`
+		Heartbeat time.Duration `mapstructure:"heartbeat"`
+	} `mapstructure:"events"`
+}
+
+type GRPCConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Port    string `mapstructure:"port"`
 }
 
 type DatabaseConfig struct {
 	SQL struct {
-		URL string `mapstructure:"url"`
+		URL         string   `mapstructure:"url"`
+		ReplicaURLs []string `mapstructure:"replica_urls"`
+		Pool        struct {
+			MaxOpenConns    int           `mapstructure:"max_open_conns"`
+			MaxIdleConns    int           `mapstructure:"max_idle_conns"`
+			ConnMaxLifetime time.Duration `mapstructure:"conn_max_lifetime"`
+			ConnMaxIdleTime time.Duration `mapstructure:"conn_max_idle_time"`
+		} `mapstructure:"pool"`
+		Ping struct {
+			Retries    int           `mapstructure:"retries"`
+			Backoff    time.Duration `mapstructure:"backoff"`
+			MaxBackoff time.Duration `mapstructure:"max_backoff"`
+			Timeout    time.Duration `mapstructure:"timeout"`
+		} `mapstructure:"ping"`
 	} `mapstructure:"sql"`
 }
 
 type KafkaConfig struct {
 	Broker string `mapstructure:"broker"`
+	Format string `mapstructure:"format"` // legacy, cloudevents-structured or cloudevents-binary
 }
 
 type JWTConfig struct {
 	User struct {
 		AccessToken struct {
-			SecretKey     string        `mapstructure:"secret_key"`
+			SecretKey     string        `mapstructure:"secret_key" secret:"true"`
 			TokenLifetime time.Duration `mapstructure:"token_lifetime"`
-		} `mapstructure:"user"`
-	}
+		} `mapstructure:"access_token"`
+	} `mapstructure:"user"`
 	Invites struct {
-		SecretKey string `mapstructure:"secret_key"`
-	} `mapstructure:"admin-invite"`
+		SecretKey string `mapstructure:"secret_key" secret:"true"`
+	} `mapstructure:"admin-invites"`
 }
 
 type SwaggerConfig struct {
@@ -60,32 +97,241 @@ type SwaggerConfig struct {
 	Port    string `mapstructure:"port"`
 }
 
+type MetricsConfig struct {
+	Enabled bool   `mapstructure:"enabled"`
+	Port    string `mapstructure:"port"`
+	Path    string `mapstructure:"path"`
+}
+
+type TracingConfig struct {
+	Enabled     bool    `mapstructure:"enabled"`
+	Exporter    string  `mapstructure:"exporter"`
+	Endpoint    string  `mapstructure:"endpoint"`
+	Insecure    bool    `mapstructure:"insecure"`
+	SampleRatio float64 `mapstructure:"sample_ratio"`
+}
+
+type WebhooksConfig struct {
+	Enabled      bool          `mapstructure:"enabled"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	PollInterval time.Duration `mapstructure:"poll_interval"`
+	BatchSize    uint64        `mapstructure:"batch_size"`
+	MaxAttempts  int           `mapstructure:"max_attempts"`
+	Backoff      time.Duration `mapstructure:"backoff"`
+	MaxBackoff   time.Duration `mapstructure:"max_backoff"`
+}
+
+// RateLimitPolicy allows Requests per Per on average and bursts of up to
+// Burst requests, Burst defaults to Requests.
+type RateLimitPolicy struct {
+	Requests int           `mapstructure:"requests"`
+	Per      time.Duration `mapstructure:"per"`
+	Burst    int           `mapstructure:"burst"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `mapstructure:"enabled"`
+	// TrustProxy takes the client IP from X-Forwarded-For, only enable it
+	// behind a proxy which overwrites the header.
+	TrustProxy bool            `mapstructure:"trust_proxy"`
+	Reads      RateLimitPolicy `mapstructure:"reads"`
+	Writes     RateLimitPolicy `mapstructure:"writes"`
+	Invites    RateLimitPolicy `mapstructure:"invites"`
+}
+
+type IdempotencyConfig struct {
+	// TTL is how long keys and their responses are kept for replays.
+	TTL time.Duration `mapstructure:"ttl"`
+	// Lease is how long a request holds its key while it is handled, a key
+	// whose request outlived it is taken over by the next retry.
+	Lease         time.Duration `mapstructure:"lease"`
+	PurgeInterval time.Duration `mapstructure:"purge_interval"`
+}
+
+type HealthConfig struct {
+	Timeout       time.Duration `mapstructure:"timeout"`
+	ShutdownDelay time.Duration `mapstructure:"shutdown_delay"`
+}
+
 type Config struct {
-	Service  ServerConfig   `mapstructure:"service"`
-	Profile  ProfileConfig  `mapstructure:"profile"`
-	Log      LogConfig      `mapstructure:"log"`
-	Rest     RestConfig     `mapstructure:"rest"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	Kafka    KafkaConfig    `mapstructure:"kafka"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Swagger  SwaggerConfig  `mapstructure:"swagger"`
-}
-
-func LoadConfig() Config {
-	configPath := os.Getenv("KV_VIPER_FILE")
-	if configPath == "" {
-		panic(fmt.Errorf("KV_VIPER_FILE env var is not set"))
+	Server      ServerConfig      `mapstructure:"server"`
+	Profile     ProfileConfig     `mapstructure:"profile"`
+	Log         LogConfig         `mapstructure:"log"`
+	Rest        RestConfig        `mapstructure:"rest"`
+	GRPC        GRPCConfig        `mapstructure:"grpc"`
+	JWT         JWTConfig         `mapstructure:"jwt"`
+	Kafka       KafkaConfig       `mapstructure:"kafka"`
+	Database    DatabaseConfig    `mapstructure:"database"`
+	Swagger     SwaggerConfig     `mapstructure:"swagger"`
+	Metrics     MetricsConfig     `mapstructure:"metrics"`
+	Tracing     TracingConfig     `mapstructure:"tracing"`
+	Webhooks    WebhooksConfig    `mapstructure:"webhooks"`
+	RateLimit   RateLimitConfig   `mapstructure:"rate_limit"`
+	Idempotency IdempotencyConfig `mapstructure:"idempotency"`
+	Health      HealthConfig      `mapstructure:"health"`
+}
+
+// LoadConfig reads the optional file given by KV_VIPER_FILE, applies env var
+// overrides and rejects keys which do not map to a Config field.
+func LoadConfig() (Config, error) {
+	v := viper.New()
+
+	if configPath := os.Getenv("KV_VIPER_FILE"); configPath != "" {
+		v.SetConfigFile(configPath)
+		if err := v.ReadInConfig(); err != nil {
+			return Config{}, fmt.Errorf("error reading config file: %w", err)
+		}
 	}
-	viper.SetConfigFile(configPath)
 
-	if err := viper.ReadInConfig(); err != nil {
-		panic(fmt.Errorf("error reading config file: %s", err))
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
+	for _, key := range configKeys(reflect.TypeOf(Config{}), "") {
+		if err := v.BindEnv(key); err != nil {
+			return Config{}, fmt.Errorf("error binding env for %s: %w", key, err)
+		}
 	}
 
 	var config Config
-	if err := viper.Unmarshal(&config); err != nil {
-		panic(fmt.Errorf("error unmarshalling config: %s", err))
+	err := v.UnmarshalExact(&config, viper.DecodeHook(mapstructure.ComposeDecodeHookFunc(
+		durationWithUnitHook,
+		mapstructure.StringToTimeDurationHookFunc(),
+		mapstructure.StringToSliceHookFunc(","),
+	)))
+	if err != nil {
+		return Config{}, fmt.Errorf("error unmarshalling config: %w", err)
+	}
+
+	return config, nil
+}
+
+// Validate reports every invalid or missing value at once.
+func (c Config) Validate() error {
+	var errs []error
+	check := func(ok bool, key, format string, args ...any) {
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+		}
 	}
 
-	return config
+	check(c.Server.Name != "", "server.name", "is required")
+
+	_, err := logrus.ParseLevel(c.Log.Level)
+	check(err == nil, "log.level", "unknown level %q", c.Log.Level)
+	check(c.Log.Format == "text" || c.Log.Format == "json", "log.format", "must be text or json, got %q", c.Log.Format)
+
+	check(c.Rest.Port != "", "rest.port", "is required")
+	check(c.Rest.Timeouts.Read >= 0, "rest.timeouts.read", "must not be negative")
+	check(c.Rest.Timeouts.ReadHeader >= 0, "rest.timeouts.read_header", "must not be negative")
+	check(c.Rest.Timeouts.Write >= 0, "rest.timeouts.write", "must not be negative")
+	check(c.Rest.Timeouts.Idle >= 0, "rest.timeouts.idle", "must not be negative")
+	check(c.Rest.Events.Buffer > 0, "rest.events.buffer", "must be positive")
+	check(c.Rest.Events.Heartbeat > 0, "rest.events.heartbeat", "must be positive")
+
+	check(!c.GRPC.Enabled || c.GRPC.Port != "", "grpc.port", "is required when grpc is enabled")
+
+	_, err = url.ParseRequestURI(c.Profile.Url)
+	check(err == nil, "profile.url", "must be an absolute url, got %q", c.Profile.Url)
+	check(c.Profile.Timeout > 0, "profile.timeout", "must be positive")
+	check(c.Profile.Retries >= 0, "profile.retries", "must not be negative")
+	check(c.Profile.CacheTTL >= 0, "profile.cache_ttl", "must not be negative")
+
+	check(c.Database.SQL.URL != "", "database.sql.url", "is required")
+	for i, u := range c.Database.SQL.ReplicaURLs {
+		check(u != "", fmt.Sprintf("database.sql.replica_urls[%d]", i), "must not be empty")
+	}
+	check(c.Database.SQL.Pool.MaxOpenConns >= 0, "database.sql.pool.max_open_conns", "must not be negative")
+	check(c.Database.SQL.Pool.MaxIdleConns >= 0, "database.sql.pool.max_idle_conns", "must not be negative")
+	check(c.Database.SQL.Pool.ConnMaxLifetime >= 0, "database.sql.pool.conn_max_lifetime", "must not be negative")
+	check(c.Database.SQL.Pool.ConnMaxIdleTime >= 0, "database.sql.pool.conn_max_idle_time", "must not be negative")
+	check(c.Database.SQL.Ping.Retries >= 0, "database.sql.ping.retries", "must not be negative")
+	check(c.Database.SQL.Ping.Backoff >= 0, "database.sql.ping.backoff", "must not be negative")
+	check(c.Database.SQL.Ping.Retries == 0 || c.Database.SQL.Ping.MaxBackoff >= c.Database.SQL.Ping.Backoff,
+		"database.sql.ping.max_backoff", "must not be less than database.sql.ping.backoff")
+	check(c.Database.SQL.Ping.Timeout >= 0, "database.sql.ping.timeout", "must not be negative")
+	check(c.Kafka.Broker != "", "kafka.broker", "is required")
+	check(c.Kafka.Format == "legacy" || c.Kafka.Format == "cloudevents-structured" || c.Kafka.Format == "cloudevents-binary",
+		"kafka.format", "must be legacy, cloudevents-structured or cloudevents-binary, got %q", c.Kafka.Format)
+
+	check(c.JWT.User.AccessToken.SecretKey != "", "jwt.user.access_token.secret_key", "is required")
+	check(c.JWT.User.AccessToken.TokenLifetime > 0, "jwt.user.access_token.token_lifetime", "must be positive")
+
+	check(!c.Swagger.Enabled || c.Swagger.Port != "", "swagger.port", "is required when swagger is enabled")
+	check(!c.Swagger.Enabled || c.Swagger.URL != "", "swagger.url", "is required when swagger is enabled")
+
+	check(!c.Metrics.Enabled || c.Metrics.Port != "", "metrics.port", "is required when metrics are enabled")
+	check(!c.Metrics.Enabled || strings.HasPrefix(c.Metrics.Path, "/"), "metrics.path", "must start with /")
+
+	if c.Tracing.Enabled {
+		check(c.Tracing.Exporter == "otlp" || c.Tracing.Exporter == "stdout",
+			"tracing.exporter", "must be otlp or stdout, got %q", c.Tracing.Exporter)
+		check(c.Tracing.Exporter != "otlp" || c.Tracing.Endpoint != "", "tracing.endpoint", "is required for otlp exporter")
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, "tracing.sample_ratio", "must be between 0 and 1")
+
+	if c.Webhooks.Enabled {
+		check(c.Webhooks.Timeout > 0, "webhooks.timeout", "must be positive")
+		check(c.Webhooks.PollInterval > 0, "webhooks.poll_interval", "must be positive")
+		check(c.Webhooks.BatchSize > 0, "webhooks.batch_size", "must be positive")
+		check(c.Webhooks.MaxAttempts > 0, "webhooks.max_attempts", "must be positive")
+		check(c.Webhooks.Backoff > 0, "webhooks.backoff", "must be positive")
+		check(c.Webhooks.MaxBackoff >= c.Webhooks.Backoff, "webhooks.max_backoff", "must not be less than webhooks.backoff")
+	}
+
+	if c.RateLimit.Enabled {
+		for _, p := range []struct {
+			key    string
+			policy RateLimitPolicy
+		}{
+			{"rate_limit.reads", c.RateLimit.Reads},
+			{"rate_limit.writes", c.RateLimit.Writes},
+			{"rate_limit.invites", c.RateLimit.Invites},
+		} {
+			check(p.policy.Requests > 0, p.key+".requests", "must be positive")
+			check(p.policy.Per > 0, p.key+".per", "must be positive")
+			check(p.policy.Burst >= 0, p.key+".burst", "must not be negative")
+		}
+	}
+
+	check(c.Idempotency.TTL > 0, "idempotency.ttl", "must be positive")
+	check(c.Idempotency.Lease > 0, "idempotency.lease", "must be positive")
+	check(c.Idempotency.PurgeInterval > 0, "idempotency.purge_interval", "must be positive")
+
+	check(c.Health.Timeout >= 0, "health.timeout", "must not be negative")
+	check(c.Health.ShutdownDelay >= 0, "health.shutdown_delay", "must not be negative")
+
+	return errors.Join(errs...)
+}
+
+// configKeys lists the dotted mapstructure keys of every leaf field of t.
+func configKeys(t reflect.Type, prefix string) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key := prefix + f.Tag.Get("mapstructure")
+
+		if f.Type.Kind() == reflect.Struct {
+			keys = append(keys, configKeys(f.Type, key+".")...)
+			continue
+		}
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// durationWithUnitHook rejects bare numbers for durations, they would
+// otherwise be read as nanoseconds.
+func durationWithUnitHook(from, to reflect.Type, data any) (any, error) {
+	if to != reflect.TypeOf(time.Duration(0)) {
+		return data, nil
+	}
+
+	switch from.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return nil, fmt.Errorf("duration %v has no unit, use a value like 15m or 30s", data)
+	default:
+		return data, nil
+	}
 }
@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"reflect"
+	"strconv"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "[REDACTED]"
+
+// Print writes the config as YAML in field order, values of fields tagged
+// secret and passwords embedded in urls are redacted.
+func (c Config) Print(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	defer enc.Close()
+
+	return enc.Encode(configNode(reflect.ValueOf(c)))
+}
+
+func configNode(v reflect.Value) *yaml.Node {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		key := &yaml.Node{Kind: yaml.ScalarNode, Value: f.Tag.Get("mapstructure")}
+
+		var value *yaml.Node
+		switch {
+		case f.Type.Kind() == reflect.Struct:
+			value = configNode(v.Field(i))
+		case f.Tag.Get("secret") == "true":
+			value = scalar(redacted)
+		case f.Type.Kind() == reflect.Slice:
+			value = &yaml.Node{Kind: yaml.SequenceNode}
+			for j := 0; j < v.Field(i).Len(); j++ {
+				value.Content = append(value.Content, scalar(configValue(v.Field(i).Index(j))))
+			}
+		default:
+			value = scalar(configValue(v.Field(i)))
+		}
+
+		node.Content = append(node.Content, key, value)
+	}
+
+	return node
+}
+
+func configValue(v reflect.Value) string {
+	switch x := v.Interface().(type) {
+	case time.Duration:
+		return x.String()
+	case string:
+		if u, err := url.Parse(x); err == nil && u.User != nil {
+			if _, ok := u.User.Password(); ok {
+				return u.Redacted()
+			}
+		}
+		return x
+	case bool:
+		return strconv.FormatBool(x)
+	default:
+		return fmt.Sprint(x)
+	}
+}
+
+func scalar(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Value: value}
+}
@@ -0,0 +1,139 @@
+package internal
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeConfig(t *testing.T, content string) {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+	t.Setenv("KV_VIPER_FILE", path)
+}
+
+func TestLoadConfigRepoFile(t *testing.T) {
+	t.Setenv("KV_VIPER_FILE", "../config.yaml")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	if err = cfg.Validate(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+
+	if cfg.Server.Name != "cities-svc" {
+		t.Errorf("server.name = %q", cfg.Server.Name)
+	}
+	if cfg.JWT.User.AccessToken.TokenLifetime != 15*time.Minute {
+		t.Errorf("token_lifetime = %s", cfg.JWT.User.AccessToken.TokenLifetime)
+	}
+	if cfg.JWT.Invites.SecretKey == "" {
+		t.Error("jwt.admin-invites.secret_key is empty")
+	}
+}
+
+func TestLoadConfigEnvOverrides(t *testing.T) {
+	t.Setenv("KV_VIPER_FILE", "")
+	t.Setenv("CITIES_DATABASE_SQL_URL", "postgres://u:p@db/cities")
+	t.Setenv("CITIES_DATABASE_SQL_REPLICA_URLS", "postgres://r1/cities,postgres://r2/cities")
+	t.Setenv("CITIES_PROFILE_TIMEOUT", "3s")
+	t.Setenv("CITIES_GRPC_ENABLED", "true")
+	t.Setenv("CITIES_JWT_ADMIN_INVITES_SECRET_KEY", "invites")
+
+	cfg, err := LoadConfig()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	if cfg.Database.SQL.URL != "postgres://u:p@db/cities" {
+		t.Errorf("database.sql.url = %q", cfg.Database.SQL.URL)
+	}
+	if len(cfg.Database.SQL.ReplicaURLs) != 2 || cfg.Database.SQL.ReplicaURLs[1] != "postgres://r2/cities" {
+		t.Errorf("database.sql.replica_urls = %q", cfg.Database.SQL.ReplicaURLs)
+	}
+	if cfg.Profile.Timeout != 3*time.Second {
+		t.Errorf("profile.timeout = %s", cfg.Profile.Timeout)
+	}
+	if !cfg.GRPC.Enabled {
+		t.Error("grpc.enabled is false")
+	}
+	if cfg.JWT.Invites.SecretKey != "invites" {
+		t.Errorf("jwt.admin-invites.secret_key = %q", cfg.JWT.Invites.SecretKey)
+	}
+}
+
+func TestLoadConfigStrict(t *testing.T) {
+	cases := map[string]string{
+		"unknown key":       "service:\n  name: cities-svc\n",
+		"duration w/o unit": "jwt:\n  user:\n    access_token:\n      token_lifetime: 900\n",
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			writeConfig(t, content)
+
+			if _, err := LoadConfig(); err == nil {
+				t.Fatal("expected an error")
+			}
+		})
+	}
+}
+
+func TestValidateReportsAll(t *testing.T) {
+	err := Config{}.Validate()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+
+	for _, key := range []string{"server.name", "log.level", "rest.port", "database.sql.url", "kafka.broker", "kafka.format"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("error misses %s:\n%v", key, err)
+		}
+	}
+}
+
+func TestPrintRedactsSecrets(t *testing.T) {
+	var cfg Config
+	cfg.Database.SQL.URL = "postgres://user:hunter2@db/cities"
+	cfg.JWT.User.AccessToken.SecretKey = "topsecret"
+	cfg.Profile.Timeout = 2 * time.Second
+
+	var buf bytes.Buffer
+	if err := cfg.Print(&buf); err != nil {
+		t.Fatalf("print: %v", err)
+	}
+	out := buf.String()
+
+	for _, secret := range []string{"hunter2", "topsecret"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("output contains %q:\n%s", secret, out)
+		}
+	}
+	if !strings.Contains(out, "timeout: 2s") {
+		t.Errorf("output misses profile timeout:\n%s", out)
+	}
+}
+
+func TestValidatePingBackoff(t *testing.T) {
+	var c Config
+	c.Database.SQL.Ping.Retries = 3
+	c.Database.SQL.Ping.Backoff = time.Second
+
+	if err := c.Validate(); err == nil || !strings.Contains(err.Error(), "database.sql.ping.max_backoff") {
+		t.Errorf("missing max_backoff not reported: %v", err)
+	}
+
+	c.Database.SQL.Ping.MaxBackoff = 10 * time.Second
+	if err := c.Validate(); err != nil && strings.Contains(err.Error(), "database.sql.ping.max_backoff") {
+		t.Errorf("valid max_backoff reported: %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+package enum
+
+const (
+	CityAdminPermissionUpdateCity       = "city:update"
+	CityAdminPermissionUpdateCityStatus = "city:update_status"
+	CityAdminPermissionInviteAdmins     = "admins:invite"
+	CityAdminPermissionUpdateAdmins     = "admins:update"
+	CityAdminPermissionDeleteAdmins     = "admins:delete"
+	CityAdminPermissionUpdateOwn        = "self:update"
+	CityAdminPermissionRefuseOwn        = "self:refuse"
+)
+
+// GetCityAdminPermissions returns the actions a city admin with the given role
+// is allowed to perform in their city, it mirrors the checks of the domain
+// services. Keep it in sync with the routes that are open to city admins.
+func GetCityAdminPermissions(role string) []string {
+	switch role {
+	case CityAdminRoleTechLead:
+		return []string{
+			CityAdminPermissionUpdateCity,
+			CityAdminPermissionUpdateCityStatus,
+			CityAdminPermissionInviteAdmins,
+			CityAdminPermissionUpdateAdmins,
+			CityAdminPermissionDeleteAdmins,
+			CityAdminPermissionUpdateOwn,
+		}
+	case CityAdminRoleModerator:
+		return []string{
+			CityAdminPermissionUpdateCity,
+			CityAdminPermissionInviteAdmins,
+			CityAdminPermissionUpdateAdmins,
+			CityAdminPermissionDeleteAdmins,
+			CityAdminPermissionUpdateOwn,
+			CityAdminPermissionRefuseOwn,
+		}
+	case CityAdminRoleChief, CityAdminRoleViceChief, CityAdminRoleMember:
+		return []string{
+			CityAdminPermissionUpdateOwn,
+			CityAdminPermissionRefuseOwn,
+		}
+	default:
+		return []string{}
+	}
+}
@@ -6,12 +6,14 @@ const (
 	InviteStatusSent     = "sent"
 	InviteStatusAccepted = "accepted"
 	InviteStatusDeclined = "declined"
+	InviteStatusRevoked  = "revoked"
 )
 
 var allInviteStatuses = []string{
 	InviteStatusSent,
 	InviteStatusAccepted,
 	InviteStatusDeclined,
+	InviteStatusRevoked,
 }
 
 var ErrorInvalidInviteStatus = fmt.Errorf("invalid invite status")
@@ -0,0 +1,31 @@
+package enum
+
+import "fmt"
+
+const (
+	WebhookDeliveryStatusPending   = "pending"
+	WebhookDeliveryStatusDelivered = "delivered"
+	WebhookDeliveryStatusDead      = "dead"
+)
+
+var allWebhookDeliveryStatuses = []string{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusDelivered,
+	WebhookDeliveryStatusDead,
+}
+
+var ErrorInvalidWebhookDeliveryStatus = fmt.Errorf("invalid webhook delivery status")
+
+func CheckWebhookDeliveryStatus(status string) error {
+	for _, s := range allWebhookDeliveryStatuses {
+		if s == status {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("'%s', %w", status, ErrorInvalidWebhookDeliveryStatus)
+}
+
+func GetAllWebhookDeliveryStatuses() []string {
+	return allWebhookDeliveryStatuses
+}
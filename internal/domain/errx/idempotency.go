@@ -0,0 +1,9 @@
+package errx
+
+import "github.com/chains-lab/ape"
+
+var ErrorInvalidIdempotencyKey = ape.DeclareError("INVALID_IDEMPOTENCY_KEY")
+
+var ErrorIdempotencyKeyReused = ape.DeclareError("IDEMPOTENCY_KEY_REUSED")
+
+var ErrorIdempotencyKeyInProgress = ape.DeclareError("IDEMPOTENCY_KEY_IN_PROGRESS")
@@ -9,3 +9,7 @@ var ErrorInviteExpired = ape.DeclareError("INVITE_EXPIRED")
 var ErrorInviteAlreadyReplied = ape.DeclareError("INVITE_ALREADY_REPLIED")
 
 var ErrorInvalidInviteReply = ape.DeclareError("INVALID_INVITE_ANSWER")
+
+var ErrorInviteeNotFound = ape.DeclareError("INVITEE_NOT_FOUND")
+
+var ErrorInvalidInviteStatus = ape.DeclareError("INVALID_INVITE_STATUS")
@@ -0,0 +1,11 @@
+package errx
+
+import "github.com/chains-lab/ape"
+
+var ErrorWebhookNotFound = ape.DeclareError("WEBHOOK_NOT_FOUND")
+
+var ErrorInvalidWebhookURL = ape.DeclareError("INVALID_WEBHOOK_URL")
+
+var ErrorWebhookDeliveryNotFound = ape.DeclareError("WEBHOOK_DELIVERY_NOT_FOUND")
+
+var ErrorWebhookDeliveryNotDead = ape.DeclareError("WEBHOOK_DELIVERY_NOT_DEAD")
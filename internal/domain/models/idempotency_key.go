@@ -0,0 +1,32 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKey is a request made with an Idempotency-Key header, the
+// response is stored once the request is handled. Until then the request
+// holds the key up to LockedUntil.
+type IdempotencyKey struct {
+	UserID      uuid.UUID
+	Key         string
+	Fingerprint string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	LockedUntil time.Time
+	ExpiresAt   time.Time
+	CreatedAt   time.Time
+}
+
+func (k IdempotencyKey) IsNil() bool {
+	return k.Key == ""
+}
+
+// Completed reports whether the response of the request is stored, it is not
+// while the first request with the key is still being handled.
+func (k IdempotencyKey) Completed() bool {
+	return k.StatusCode != 0
+}
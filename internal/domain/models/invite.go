@@ -20,3 +20,10 @@ type Invite struct {
 func (i Invite) IsNil() bool {
 	return i.ID == uuid.Nil
 }
+
+type InvitesCollection struct {
+	Data  []Invite `json:"data"`
+	Page  uint64   `json:"page"`
+	Size  uint64   `json:"size"`
+	Total uint64   `json:"total"`
+}
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Webhook struct {
+	ID         uuid.UUID `json:"id"`
+	URL        string    `json:"url"`
+	Secret     string    `json:"-"`
+	EventTypes []string  `json:"event_types"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+func (w Webhook) IsNil() bool {
+	return w.ID == uuid.Nil
+}
+
+// Accepts reports whether the webhook is subscribed to the event type, a
+// webhook without event types receives every event.
+func (w Webhook) Accepts(eventType string) bool {
+	return len(w.EventTypes) == 0 || slices.Contains(w.EventTypes, eventType)
+}
+
+type WebhookDelivery struct {
+	ID            uuid.UUID       `json:"id"`
+	WebhookID     uuid.UUID       `json:"webhook_id"`
+	EventID       uuid.UUID       `json:"event_id"`
+	EventType     string          `json:"event_type"`
+	Payload       json.RawMessage `json:"payload"`
+	Status        string          `json:"status"`
+	Attempts      int             `json:"attempts"`
+	ResponseCode  *int            `json:"response_code,omitempty"`
+	LastError     *string         `json:"last_error,omitempty"`
+	RequestID     string          `json:"request_id,omitempty"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+	CreatedAt     time.Time       `json:"created_at"`
+}
+
+func (d WebhookDelivery) IsNil() bool {
+	return d.ID == uuid.Nil
+}
+
+type WebhookDeliveriesCollection struct {
+	Data  []WebhookDelivery `json:"data"`
+	Page  uint64            `json:"page"`
+	Size  uint64            `json:"size"`
+	Total uint64            `json:"total"`
+}
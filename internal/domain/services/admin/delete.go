@@ -108,6 +108,9 @@ func (s Service) delete(
 	admin models.CityAdmin,
 	city models.City,
 ) error {
+	ctx, span := tracer.Start(ctx, "admin.Delete")
+	defer span.End()
+
 	err := s.db.DeleteCityAdmin(ctx, admin.UserID, city.ID)
 	if err != nil {
 		return errx.ErrorInternal.Raise(
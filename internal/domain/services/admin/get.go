@@ -25,3 +25,75 @@ func (s Service) Get(ctx context.Context, initiatorID, cityID uuid.UUID) (models
 
 	return res, nil
 }
+
+// GetByUser returns every city admin membership of the user.
+func (s Service) GetByUser(ctx context.Context, userID uuid.UUID) ([]models.CityAdmin, error) {
+	res, err := s.db.GetUserCityAdmins(ctx, userID)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get city admins for user %s, cause: %w", userID, err),
+		)
+	}
+
+	return res, nil
+}
+
+// GetByCities returns the admins of every given city.
+func (s Service) GetByCities(ctx context.Context, cityIDs ...uuid.UUID) ([]models.CityAdmin, error) {
+	if len(cityIDs) == 0 {
+		return []models.CityAdmin{}, nil
+	}
+
+	res, err := s.db.GetAdminsForCities(ctx, cityIDs)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get admins for cities, cause: %w", err),
+		)
+	}
+
+	return res, nil
+}
+
+// Key identifies a city admin membership.
+type Key struct {
+	UserID uuid.UUID
+	CityID uuid.UUID
+}
+
+// GetByKeys returns the memberships in the order of keys, duplicated keys are returned once,
+// keys that do not match any membership are returned separately.
+func (s Service) GetByKeys(ctx context.Context, keys []Key) ([]models.CityAdmin, []Key, error) {
+	if len(keys) == 0 {
+		return []models.CityAdmin{}, []Key{}, nil
+	}
+
+	rows, err := s.db.GetCityAdminsByKeys(ctx, keys)
+	if err != nil {
+		return nil, nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get city admins by keys, cause: %w", err),
+		)
+	}
+
+	byKey := make(map[Key]models.CityAdmin, len(rows))
+	for _, a := range rows {
+		byKey[Key{UserID: a.UserID, CityID: a.CityID}] = a
+	}
+
+	admins := make([]models.CityAdmin, 0, len(rows))
+	notFound := make([]Key, 0)
+	seen := make(map[Key]bool, len(keys))
+	for _, k := range keys {
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+
+		if a, ok := byKey[k]; ok {
+			admins = append(admins, a)
+		} else {
+			notFound = append(notFound, k)
+		}
+	}
+
+	return admins, notFound, nil
+}
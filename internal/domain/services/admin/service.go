@@ -8,8 +8,11 @@ import (
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/domain/services/admin")
+
 type Service struct {
 	db    database
 	event EventPublisher
@@ -27,6 +30,9 @@ type database interface {
 
 	CreateCityAdmin(ctx context.Context, input models.CityAdmin) error
 	GetCityAdmin(ctx context.Context, userID, cityID uuid.UUID) (models.CityAdmin, error)
+	GetCityAdminsByKeys(ctx context.Context, keys []Key) ([]models.CityAdmin, error)
+	GetUserCityAdmins(ctx context.Context, userID uuid.UUID) ([]models.CityAdmin, error)
+	GetAdminsForCities(ctx context.Context, cityIDs []uuid.UUID) ([]models.CityAdmin, error)
 	GetCityTechLead(ctx context.Context, cityID uuid.UUID) (models.CityAdmin, error)
 
 	FilterCityAdmins(ctx context.Context, filter FilterParams, page, size uint64) (models.CityAdminsCollection, error)
@@ -24,6 +24,9 @@ func (s Service) UpdateByCityAdmin(
 	cityID uuid.UUID,
 	params UpdateParams,
 ) (models.CityAdmin, error) {
+	ctx, span := tracer.Start(ctx, "admin.UpdateByCityAdmin")
+	defer span.End()
+
 	initiator, err := s.validateInitiator(
 		ctx, initiatorID, cityID,
 		enum.CityAdminRoleTechLead, enum.CityAdminRoleModerator,
@@ -120,6 +123,9 @@ func (s Service) UpdateBySysAdmin(
 	cityID uuid.UUID,
 	params UpdateParams,
 ) (models.CityAdmin, error) {
+	ctx, span := tracer.Start(ctx, "admin.UpdateBySysAdmin")
+	defer span.End()
+
 	admin, err := s.Get(ctx, userID, cityID)
 	if err != nil {
 		return models.CityAdmin{}, err
@@ -202,6 +208,9 @@ func (s Service) UpdateOwn(
 	cityID uuid.UUID,
 	params UpdateOwnParams,
 ) (models.CityAdmin, error) {
+	ctx, span := tracer.Start(ctx, "admin.UpdateOwn")
+	defer span.End()
+
 	admin, err := s.validateInitiator(ctx, userID, cityID)
 	if err != nil {
 		return models.CityAdmin{}, err
@@ -23,6 +23,9 @@ type CreateParams struct {
 }
 
 func (s Service) Create(ctx context.Context, params CreateParams) (models.City, error) {
+	ctx, span := tracer.Start(ctx, "city.Create")
+	defer span.End()
+
 	err := validateTimezone(params.Timezone)
 	if err != nil {
 		return models.City{}, err
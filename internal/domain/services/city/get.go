@@ -60,3 +60,41 @@ func (s Service) GetBySlug(ctx context.Context, slug string) (models.City, error
 
 	return city, nil
 }
+
+// GetByIDs returns the cities in the order of ids, duplicated ids are returned once,
+// ids that do not match any city are returned separately.
+func (s Service) GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.City, []uuid.UUID, error) {
+	if len(ids) == 0 {
+		return []models.City{}, []uuid.UUID{}, nil
+	}
+
+	rows, err := s.db.GetCitiesByIDs(ctx, ids)
+	if err != nil {
+		return nil, nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get cities by ids, cause: %w", err),
+		)
+	}
+
+	byID := make(map[uuid.UUID]models.City, len(rows))
+	for _, c := range rows {
+		byID[c.ID] = c
+	}
+
+	cities := make([]models.City, 0, len(rows))
+	notFound := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		if c, ok := byID[id]; ok {
+			cities = append(cities, c)
+		} else {
+			notFound = append(notFound, id)
+		}
+	}
+
+	return cities, notFound, nil
+}
@@ -11,8 +11,11 @@ import (
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/domain/services/city")
+
 type Service struct {
 	db    database
 	event event
@@ -95,6 +98,7 @@ type database interface {
 	GetCityByID(ctx context.Context, id uuid.UUID) (models.City, error)
 	GetCityBySlug(ctx context.Context, slug string) (models.City, error)
 	GetCityByRadius(ctx context.Context, point orb.Point, radius uint64) (models.City, error)
+	GetCitiesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.City, error)
 	GetCityAdmins(ctx context.Context, cityID uuid.UUID, roles ...string) (models.CityAdminsCollection, error)
 	GetCityAdmin(ctx context.Context, userID, cityID uuid.UUID) (models.CityAdmin, error)
 	FilterCities(ctx context.Context, filter FilterParams, page, size uint64) (models.CitiesCollection, error)
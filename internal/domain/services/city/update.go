@@ -52,6 +52,9 @@ func (s Service) update(
 	cityID uuid.UUID,
 	params UpdateParams,
 ) (models.City, error) {
+	ctx, span := tracer.Start(ctx, "city.Update")
+	defer span.End()
+
 	city, err := s.GetByID(ctx, cityID)
 	if err != nil {
 		return models.City{}, err
@@ -77,6 +80,9 @@ func (s Service) update(
 
 	if params.Slug != nil {
 		err = validateSlug(*params.Slug)
+		if err != nil {
+			return models.City{}, err
+		}
 
 		_, err = s.GetBySlug(ctx, *params.Slug)
 		if err != nil && !errors.Is(err, errx.ErrorCityNotFound) {
@@ -157,6 +163,9 @@ func (s Service) updateStatus(
 	cityID uuid.UUID,
 	status string,
 ) (models.City, error) {
+	ctx, span := tracer.Start(ctx, "city.UpdateStatus")
+	defer span.End()
+
 	err := enum.CheckCityStatus(status)
 	if err != nil {
 		return models.City{}, errx.ErrorInvalidCityStatus.Raise(err)
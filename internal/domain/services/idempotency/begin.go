@@ -0,0 +1,88 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// MaxKeyLength is the longest Idempotency-Key accepted.
+const MaxKeyLength = 255
+
+// Begin claims the key of the user for a request with the given fingerprint.
+// A completed key is returned to replay its response, otherwise the caller
+// handles the request and has to Complete or Release the key afterwards.
+// Expired keys, and keys whose request outlived its lease because its instance
+// went down, are treated as unused.
+func (s Service) Begin(ctx context.Context, userID uuid.UUID, key, fingerprint string) (models.IdempotencyKey, error) {
+	ctx, span := tracer.Start(ctx, "idempotency.Begin")
+	defer span.End()
+
+	if key == "" || len(key) > MaxKeyLength {
+		return models.IdempotencyKey{}, errx.ErrorInvalidIdempotencyKey.Raise(
+			fmt.Errorf("idempotency key must be 1 to %d characters long", MaxKeyLength),
+		)
+	}
+
+	now := time.Now().UTC()
+
+	var res models.IdempotencyKey
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		stored, err := s.db.GetIdempotencyKey(ctx, userID, key)
+		if err != nil {
+			return errx.ErrorInternal.Raise(fmt.Errorf("failed to get idempotency key, cause: %w", err))
+		}
+
+		if !stored.IsNil() && stored.ExpiresAt.After(now) {
+			switch {
+			case stored.Fingerprint != fingerprint:
+				return errx.ErrorIdempotencyKeyReused.Raise(
+					fmt.Errorf("idempotency key %q was used for a different request", key),
+				)
+			case stored.Completed():
+				res = stored
+				return nil
+			case stored.LockedUntil.After(now):
+				return errx.ErrorIdempotencyKeyInProgress.Raise(
+					fmt.Errorf("request with idempotency key %q is still in progress", key),
+				)
+			}
+		}
+
+		if !stored.IsNil() {
+			if err = s.db.DeleteIdempotencyKey(ctx, userID, key); err != nil {
+				return errx.ErrorInternal.Raise(fmt.Errorf("failed to delete stale idempotency key, cause: %w", err))
+			}
+		}
+
+		res = models.IdempotencyKey{
+			UserID:      userID,
+			Key:         key,
+			Fingerprint: fingerprint,
+			LockedUntil: now.Add(s.lease),
+			ExpiresAt:   now.Add(s.ttl),
+			CreatedAt:   now,
+		}
+		created, err := s.db.CreateIdempotencyKey(ctx, res)
+		if err != nil {
+			return errx.ErrorInternal.Raise(fmt.Errorf("failed to create idempotency key, cause: %w", err))
+		}
+		if !created {
+			// A concurrent request with the same key got there first.
+			return errx.ErrorIdempotencyKeyInProgress.Raise(
+				fmt.Errorf("request with idempotency key %q is still in progress", key),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.IdempotencyKey{}, err
+	}
+
+	return res, nil
+}
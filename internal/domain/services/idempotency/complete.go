@@ -0,0 +1,55 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/google/uuid"
+)
+
+// Complete stores the response of the request so that retries with the key
+// get it replayed.
+func (s Service) Complete(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	statusCode int,
+	contentType string,
+	body []byte,
+) error {
+	ctx, span := tracer.Start(ctx, "idempotency.Complete")
+	defer span.End()
+
+	if err := s.db.UpdateIdempotencyKeyResponse(ctx, userID, key, statusCode, contentType, body); err != nil {
+		return errx.ErrorInternal.Raise(fmt.Errorf("failed to store idempotent response, cause: %w", err))
+	}
+
+	return nil
+}
+
+// Release forgets the key of a request which failed, so that it can be
+// retried with the same key.
+func (s Service) Release(ctx context.Context, userID uuid.UUID, key string) error {
+	ctx, span := tracer.Start(ctx, "idempotency.Release")
+	defer span.End()
+
+	if err := s.db.DeleteIdempotencyKey(ctx, userID, key); err != nil {
+		return errx.ErrorInternal.Raise(fmt.Errorf("failed to release idempotency key, cause: %w", err))
+	}
+
+	return nil
+}
+
+// Purge deletes the expired keys.
+func (s Service) Purge(ctx context.Context) error {
+	ctx, span := tracer.Start(ctx, "idempotency.Purge")
+	defer span.End()
+
+	if err := s.db.DeleteExpiredIdempotencyKeys(ctx, time.Now().UTC()); err != nil {
+		return errx.ErrorInternal.Raise(fmt.Errorf("failed to purge idempotency keys, cause: %w", err))
+	}
+
+	return nil
+}
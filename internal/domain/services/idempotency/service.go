@@ -0,0 +1,45 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/domain/services/idempotency")
+
+type Service struct {
+	db    database
+	ttl   time.Duration
+	lease time.Duration
+}
+
+// NewService keeps idempotency keys and their responses for ttl, a request
+// holds its key for lease before a retry may take it over.
+func NewService(db database, ttl, lease time.Duration) Service {
+	return Service{
+		db:    db,
+		ttl:   ttl,
+		lease: lease,
+	}
+}
+
+type database interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	CreateIdempotencyKey(ctx context.Context, input models.IdempotencyKey) (bool, error)
+	GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (models.IdempotencyKey, error)
+	UpdateIdempotencyKeyResponse(
+		ctx context.Context,
+		userID uuid.UUID,
+		key string,
+		statusCode int,
+		contentType string,
+		body []byte,
+	) error
+	DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) error
+}
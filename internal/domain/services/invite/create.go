@@ -55,6 +55,9 @@ func (s Service) create(
 	initiatorID uuid.UUID,
 	params CreateParams,
 ) (models.Invite, error) {
+	ctx, span := tracer.Start(ctx, "invite.Create")
+	defer span.End()
+
 	inviteID := uuid.New()
 	now := time.Now().UTC()
 
@@ -63,6 +66,18 @@ func (s Service) create(
 		return models.Invite{}, errx.ErrorInvalidCityAdminRole.Raise(err)
 	}
 
+	exists, err := s.profiles.UserExists(ctx, params.UserID)
+	if err != nil {
+		return models.Invite{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to check invited user %s, cause: %w", params.UserID, err),
+		)
+	}
+	if !exists {
+		return models.Invite{}, errx.ErrorInviteeNotFound.Raise(
+			fmt.Errorf("invited user %s not found", params.UserID),
+		)
+	}
+
 	city, err := s.getCity(ctx, params.CityID)
 	if err != nil {
 		return models.Invite{}, err
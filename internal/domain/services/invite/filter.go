@@ -0,0 +1,62 @@
+package invite
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type FilterParams struct {
+	CityID []uuid.UUID
+	UserID []uuid.UUID
+	Status []string
+}
+
+// FilterByCityAdmin lists the invites of the city, only admins allowed to invite can see them.
+func (s Service) FilterByCityAdmin(
+	ctx context.Context,
+	initiatorID, cityID uuid.UUID,
+	filters FilterParams,
+	page, size uint64,
+) (models.InvitesCollection, error) {
+	initiator, err := s.getInitiator(ctx, initiatorID, cityID)
+	if err != nil {
+		return models.InvitesCollection{}, err
+	}
+
+	if !slices.Contains(enum.GetCityAdminPermissions(initiator.Role), enum.CityAdminPermissionInviteAdmins) {
+		return models.InvitesCollection{}, errx.ErrorNotEnoughRight.Raise(
+			fmt.Errorf("initiator %s has no rights to list invites of city %s", initiatorID, cityID),
+		)
+	}
+
+	filters.CityID = []uuid.UUID{cityID}
+
+	return s.Filter(ctx, filters, page, size)
+}
+
+func (s Service) Filter(
+	ctx context.Context,
+	filters FilterParams,
+	page, size uint64,
+) (models.InvitesCollection, error) {
+	for _, status := range filters.Status {
+		if err := enum.CheckInviteStatus(status); err != nil {
+			return models.InvitesCollection{}, errx.ErrorInvalidInviteStatus.Raise(err)
+		}
+	}
+
+	res, err := s.db.FilterInvites(ctx, filters, page, size)
+	if err != nil {
+		return models.InvitesCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to filter invites, cause: %w", err),
+		)
+	}
+
+	return res, nil
+}
@@ -3,7 +3,9 @@ package invite
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/google/uuid"
@@ -21,3 +23,15 @@ func (s Service) Get(ctx context.Context, ID uuid.UUID) (models.Invite, error) {
 
 	return inv, err
 }
+
+// GetPending returns the not expired invites sent to the user, newest first.
+func (s Service) GetPending(ctx context.Context, userID uuid.UUID) ([]models.Invite, error) {
+	res, err := s.db.GetUserInvites(ctx, userID, enum.InviteStatusSent, time.Now().UTC())
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get pending invites for user %s, cause: %w", userID, err),
+		)
+	}
+
+	return res, nil
+}
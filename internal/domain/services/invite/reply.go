@@ -16,10 +16,12 @@ func (s Service) Reply(
 	userID, inviteID uuid.UUID,
 	reply string,
 ) (models.Invite, error) {
-	err := enum.CheckInviteStatus(reply)
-	if err != nil {
+	ctx, span := tracer.Start(ctx, "invite.Reply")
+	defer span.End()
+
+	if reply != enum.InviteStatusAccepted && reply != enum.InviteStatusDeclined {
 		return models.Invite{}, errx.ErrorInvalidInviteReply.Raise(
-			fmt.Errorf("invalid invite reply: %w", err),
+			fmt.Errorf("invalid invite reply %s, must be %s or %s", reply, enum.InviteStatusAccepted, enum.InviteStatusDeclined),
 		)
 	}
 
@@ -29,6 +31,11 @@ func (s Service) Reply(
 	if err != nil {
 		return models.Invite{}, err
 	}
+	if invite.UserID != userID {
+		return models.Invite{}, errx.ErrorNotEnoughRight.Raise(
+			fmt.Errorf("invite %s is addressed to another user", inviteID),
+		)
+	}
 
 	if invite.Status != enum.InviteStatusSent {
 		return models.Invite{}, errx.ErrorInviteAlreadyReplied.Raise(
@@ -101,12 +108,7 @@ func (s Service) Reply(
 				)
 			}
 
-			if err = s.db.UpdateInviteStatus(ctx, invite.ID, enum.InviteStatusAccepted); err != nil {
-				return errx.ErrorInternal.Raise(
-					fmt.Errorf("failed to update invite status, cause: %w", err),
-				)
-			}
-			return nil
+			return s.answer(ctx, invite.ID, enum.InviteStatusAccepted)
 		}); err != nil {
 			return models.Invite{}, err
 		}
@@ -132,10 +134,8 @@ func (s Service) Reply(
 		}
 
 	case enum.InviteStatusDeclined:
-		if err = s.db.UpdateInviteStatus(ctx, invite.ID, enum.InviteStatusDeclined); err != nil {
-			return models.Invite{}, errx.ErrorInternal.Raise(
-				fmt.Errorf("failed to update invite status, cause: %w", err),
-			)
+		if err = s.answer(ctx, invite.ID, enum.InviteStatusDeclined); err != nil {
+			return models.Invite{}, err
 		}
 
 		if err = s.event.PublishInviteDeclined(ctx, invite, city, invite.InitiatorID); err != nil {
@@ -150,3 +150,21 @@ func (s Service) Reply(
 
 	return invite, nil
 }
+
+// answer moves a sent invite to the reply status, it fails if the invite has
+// been revoked or answered since it was read.
+func (s Service) answer(ctx context.Context, inviteID uuid.UUID, reply string) error {
+	updated, err := s.db.UpdateInviteStatusFrom(ctx, inviteID, enum.InviteStatusSent, reply)
+	if err != nil {
+		return errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update invite status, cause: %w", err),
+		)
+	}
+	if !updated {
+		return errx.ErrorInviteAlreadyReplied.Raise(
+			fmt.Errorf("invite %s was answered or revoked concurrently", inviteID),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package invite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+// Revoke cancels an invite which has not been answered yet.
+func (s Service) Revoke(ctx context.Context, inviteID uuid.UUID) (models.Invite, error) {
+	ctx, span := tracer.Start(ctx, "invite.Revoke")
+	defer span.End()
+
+	var invite models.Invite
+	err := s.db.Transaction(ctx, func(ctx context.Context) error {
+		var err error
+		invite, err = s.Get(ctx, inviteID)
+		if err != nil {
+			return err
+		}
+		if invite.Status != enum.InviteStatusSent {
+			return errx.ErrorInviteAlreadyReplied.Raise(
+				fmt.Errorf("invite already answered with status=%s", invite.Status),
+			)
+		}
+
+		// The invite may have been answered since it was read.
+		updated, err := s.db.UpdateInviteStatusFrom(ctx, invite.ID, enum.InviteStatusSent, enum.InviteStatusRevoked)
+		if err != nil {
+			return errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to update invite status, cause: %w", err),
+			)
+		}
+		if !updated {
+			return errx.ErrorInviteAlreadyReplied.Raise(
+				fmt.Errorf("invite %s was answered concurrently", invite.ID),
+			)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return models.Invite{}, err
+	}
+
+	invite.Status = enum.InviteStatusRevoked
+
+	return invite, nil
+}
@@ -3,21 +3,27 @@ package invite
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
 )
 
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/domain/services/invite")
+
 type Service struct {
-	db    database
-	event EventPublisher
+	db       database
+	event    EventPublisher
+	profiles Profiles
 }
 
-func NewService(db database, event EventPublisher) Service {
+func NewService(db database, event EventPublisher, profiles Profiles) Service {
 	return Service{
-		db:    db,
-		event: event,
+		db:       db,
+		event:    event,
+		profiles: profiles,
 	}
 }
 
@@ -32,11 +38,17 @@ type database interface {
 
 	CreateInvite(ctx context.Context, input models.Invite) error
 	GetInvite(ctx context.Context, ID uuid.UUID) (models.Invite, error)
-	UpdateInviteStatus(ctx context.Context, inviteID uuid.UUID, status string) error
+	FilterInvites(ctx context.Context, filter FilterParams, page, size uint64) (models.InvitesCollection, error)
+	GetUserInvites(ctx context.Context, userID uuid.UUID, status string, expiresAfter time.Time) ([]models.Invite, error)
+	UpdateInviteStatusFrom(ctx context.Context, inviteID uuid.UUID, from, to string) (bool, error)
 
 	GetCityByID(ctx context.Context, ID uuid.UUID) (models.City, error)
 }
 
+type Profiles interface {
+	UserExists(ctx context.Context, userID uuid.UUID) (bool, error)
+}
+
 type EventPublisher interface {
 	PublishInviteCreated(
 		ctx context.Context,
@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type CreateParams struct {
+	URL        string
+	Secret     string
+	EventTypes []string
+}
+
+// Create registers a webhook, a random secret is generated when none is given.
+func (s Service) Create(ctx context.Context, params CreateParams) (models.Webhook, error) {
+	ctx, span := tracer.Start(ctx, "webhook.Create")
+	defer span.End()
+
+	u, err := url.Parse(params.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return models.Webhook{}, errx.ErrorInvalidWebhookURL.Raise(
+			fmt.Errorf("webhook url must be an absolute http or https url, got %q", params.URL),
+		)
+	}
+
+	secret := params.Secret
+	if secret == "" {
+		b := make([]byte, 32)
+		if _, err = rand.Read(b); err != nil {
+			return models.Webhook{}, errx.ErrorInternal.Raise(
+				fmt.Errorf("failed to generate webhook secret, cause: %w", err),
+			)
+		}
+		secret = hex.EncodeToString(b)
+	}
+
+	res := models.Webhook{
+		ID:         uuid.New(),
+		URL:        params.URL,
+		Secret:     secret,
+		EventTypes: params.EventTypes,
+		CreatedAt:  time.Now().UTC(),
+	}
+	if err = s.db.CreateWebhook(ctx, res); err != nil {
+		return models.Webhook{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to create webhook, cause: %w", err),
+		)
+	}
+
+	return res, nil
+}
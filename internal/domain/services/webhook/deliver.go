@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+)
+
+type UpdateDeliveryParams struct {
+	Status        string
+	Attempts      int
+	ResponseCode  *int
+	LastError     *string
+	NextAttemptAt time.Time
+}
+
+// Claim returns up to limit pending deliveries which are due and postpones them
+// by lease, so that other instances skip them while they are being sent. A
+// delivery whose attempt is never recorded is retried once the lease ends.
+func (s Service) Claim(ctx context.Context, limit uint64, lease time.Duration) ([]models.WebhookDelivery, error) {
+	now := time.Now().UTC()
+
+	res, err := s.db.ClaimWebhookDeliveries(ctx, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(fmt.Errorf("failed to claim webhook deliveries, cause: %w", err))
+	}
+
+	return res, nil
+}
+
+// RecordAttempt stores the outcome of sending the delivery. A failed attempt
+// is scheduled for a retry with exponential backoff until the delivery runs
+// out of attempts and is dead.
+func (s Service) RecordAttempt(
+	ctx context.Context,
+	delivery models.WebhookDelivery,
+	responseCode int,
+	sendErr error,
+) (models.WebhookDelivery, error) {
+	now := time.Now().UTC()
+
+	params := UpdateDeliveryParams{
+		Status:        enum.WebhookDeliveryStatusDelivered,
+		Attempts:      delivery.Attempts + 1,
+		NextAttemptAt: now,
+	}
+	if responseCode != 0 {
+		params.ResponseCode = &responseCode
+	}
+
+	if sendErr != nil {
+		msg := sendErr.Error()
+		params.LastError = &msg
+		params.Status = enum.WebhookDeliveryStatusPending
+		params.NextAttemptAt = now.Add(s.backoff(params.Attempts))
+
+		if params.Attempts >= s.retry.MaxAttempts {
+			params.Status = enum.WebhookDeliveryStatusDead
+		}
+	}
+
+	if err := s.db.UpdateWebhookDelivery(ctx, delivery.ID, params, now); err != nil {
+		return models.WebhookDelivery{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update webhook delivery %s, cause: %w", delivery.ID, err),
+		)
+	}
+
+	delivery.Status = params.Status
+	delivery.Attempts = params.Attempts
+	delivery.ResponseCode = params.ResponseCode
+	delivery.LastError = params.LastError
+	delivery.NextAttemptAt = params.NextAttemptAt
+	delivery.UpdatedAt = now
+
+	return delivery, nil
+}
+
+// backoff is the delay after the given number of failed attempts.
+func (s Service) backoff(attempts int) time.Duration {
+	d := s.retry.Backoff
+	for i := 1; i < attempts && d < s.retry.MaxBackoff; i++ {
+		d *= 2
+	}
+
+	return min(d, s.retry.MaxBackoff)
+}
@@ -0,0 +1,88 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+type FilterDeliveriesParams struct {
+	WebhookID []uuid.UUID
+	Status    []string
+}
+
+// FilterDeliveries lists deliveries newest first, filtering by the dead status
+// gives the dead-letter view.
+func (s Service) FilterDeliveries(
+	ctx context.Context,
+	filters FilterDeliveriesParams,
+	page, size uint64,
+) (models.WebhookDeliveriesCollection, error) {
+	res, err := s.db.FilterWebhookDeliveries(ctx, filters, page, size)
+	if err != nil {
+		return models.WebhookDeliveriesCollection{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to filter webhook deliveries, cause: %w", err),
+		)
+	}
+
+	return res, nil
+}
+
+func (s Service) GetDelivery(ctx context.Context, ID uuid.UUID) (models.WebhookDelivery, error) {
+	res, err := s.db.GetWebhookDelivery(ctx, ID)
+	if err != nil {
+		return models.WebhookDelivery{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to get webhook delivery %s, cause: %w", ID, err),
+		)
+	}
+
+	if res.IsNil() {
+		return models.WebhookDelivery{}, errx.ErrorWebhookDeliveryNotFound.Raise(
+			fmt.Errorf("webhook delivery %s is not found", ID),
+		)
+	}
+
+	return res, nil
+}
+
+// Redeliver moves a dead delivery back to pending with a fresh set of
+// attempts, it is sent with the next batch.
+func (s Service) Redeliver(ctx context.Context, ID uuid.UUID) (models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "webhook.Redeliver")
+	defer span.End()
+
+	delivery, err := s.GetDelivery(ctx, ID)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	if delivery.Status != enum.WebhookDeliveryStatusDead {
+		return models.WebhookDelivery{}, errx.ErrorWebhookDeliveryNotDead.Raise(
+			fmt.Errorf("webhook delivery %s has status %s", ID, delivery.Status),
+		)
+	}
+
+	now := time.Now().UTC()
+	params := UpdateDeliveryParams{
+		Status:        enum.WebhookDeliveryStatusPending,
+		ResponseCode:  delivery.ResponseCode,
+		LastError:     delivery.LastError,
+		NextAttemptAt: now,
+	}
+	if err = s.db.UpdateWebhookDelivery(ctx, ID, params, now); err != nil {
+		return models.WebhookDelivery{}, errx.ErrorInternal.Raise(
+			fmt.Errorf("failed to update webhook delivery %s, cause: %w", ID, err),
+		)
+	}
+
+	delivery.Status = params.Status
+	delivery.Attempts = params.Attempts
+	delivery.NextAttemptAt = now
+	delivery.UpdatedAt = now
+
+	return delivery, nil
+}
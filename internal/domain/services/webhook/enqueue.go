@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/google/uuid"
+)
+
+// Enqueue records a pending delivery of the event for every webhook subscribed
+// to its type. The publisher calls it once the event is written to Kafka, so
+// only published events are delivered. The id of the request which caused the
+// event is kept with each delivery.
+func (s Service) Enqueue(ctx context.Context, eventID uuid.UUID, eventType string, payload []byte) error {
+	ctx, span := tracer.Start(ctx, "webhook.Enqueue")
+	defer span.End()
+
+	webhooks, err := s.db.GetWebhooks(ctx)
+	if err != nil {
+		return errx.ErrorInternal.Raise(fmt.Errorf("failed to list webhooks, cause: %w", err))
+	}
+
+	now := time.Now().UTC()
+
+	return s.db.Transaction(ctx, func(ctx context.Context) error {
+		for _, w := range webhooks {
+			if !w.Accepts(eventType) {
+				continue
+			}
+
+			err := s.db.CreateWebhookDelivery(ctx, models.WebhookDelivery{
+				ID:            uuid.New(),
+				WebhookID:     w.ID,
+				EventID:       eventID,
+				EventType:     eventType,
+				Payload:       payload,
+				RequestID:     requestid.From(ctx),
+				Status:        enum.WebhookDeliveryStatusPending,
+				NextAttemptAt: now,
+				UpdatedAt:     now,
+				CreatedAt:     now,
+			})
+			if err != nil {
+				return errx.ErrorInternal.Raise(
+					fmt.Errorf("failed to create delivery of %s to webhook %s, cause: %w", eventType, w.ID, err),
+				)
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,46 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (s Service) Get(ctx context.Context, ID uuid.UUID) (models.Webhook, error) {
+	res, err := s.db.GetWebhook(ctx, ID)
+	if err != nil {
+		return models.Webhook{}, errx.ErrorInternal.Raise(fmt.Errorf("failed to get webhook %s, cause: %w", ID, err))
+	}
+
+	if res.IsNil() {
+		return models.Webhook{}, errx.ErrorWebhookNotFound.Raise(fmt.Errorf("webhook %s is not found", ID))
+	}
+
+	return res, nil
+}
+
+// List returns every registered webhook, oldest first.
+func (s Service) List(ctx context.Context) ([]models.Webhook, error) {
+	res, err := s.db.GetWebhooks(ctx)
+	if err != nil {
+		return nil, errx.ErrorInternal.Raise(fmt.Errorf("failed to list webhooks, cause: %w", err))
+	}
+
+	return res, nil
+}
+
+// Delete removes the webhook together with its deliveries.
+func (s Service) Delete(ctx context.Context, ID uuid.UUID) error {
+	if _, err := s.Get(ctx, ID); err != nil {
+		return err
+	}
+
+	if err := s.db.DeleteWebhook(ctx, ID); err != nil {
+		return errx.ErrorInternal.Raise(fmt.Errorf("failed to delete webhook %s, cause: %w", ID, err))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/domain/services/webhook")
+
+// Retry is the delivery schedule. The n-th failed attempt is retried after
+// Backoff * 2^(n-1), capped at MaxBackoff, and the delivery is dead once it
+// failed MaxAttempts times.
+type Retry struct {
+	MaxAttempts int
+	Backoff     time.Duration
+	MaxBackoff  time.Duration
+}
+
+type Service struct {
+	db    database
+	retry Retry
+}
+
+func NewService(db database, retry Retry) Service {
+	return Service{
+		db:    db,
+		retry: retry,
+	}
+}
+
+type database interface {
+	Transaction(ctx context.Context, fn func(ctx context.Context) error) error
+
+	CreateWebhook(ctx context.Context, input models.Webhook) error
+	GetWebhook(ctx context.Context, ID uuid.UUID) (models.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]models.Webhook, error)
+	DeleteWebhook(ctx context.Context, ID uuid.UUID) error
+
+	CreateWebhookDelivery(ctx context.Context, input models.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, ID uuid.UUID) (models.WebhookDelivery, error)
+	FilterWebhookDeliveries(ctx context.Context, filter FilterDeliveriesParams, page, size uint64) (models.WebhookDeliveriesCollection, error)
+	ClaimWebhookDeliveries(ctx context.Context, now, until time.Time, limit uint64) ([]models.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, ID uuid.UUID, params UpdateDeliveryParams, updatedAt time.Time) error
+}
@@ -0,0 +1,26 @@
+package contracts
+
+import (
+	"encoding/json"
+	"time"
+)
+
+const (
+	CloudEventsSpecVersion = "1.0"
+	CloudEventsSource      = "cities-svc"
+)
+
+// CloudEvent is the structured mode representation of an event as defined by
+// CloudEvents 1.0. DataVersion is an extension attribute carrying the payload
+// version, the JSON Schema of Data is the one of the legacy envelope's data.
+type CloudEvent struct {
+	SpecVersion     string          `json:"specversion"`
+	ID              string          `json:"id"`
+	Source          string          `json:"source"`
+	Type            string          `json:"type"`
+	Subject         string          `json:"subject,omitempty"`
+	Time            time.Time       `json:"time"`
+	DataContentType string          `json:"datacontenttype"`
+	DataVersion     string          `json:"dataversion"`
+	Data            json.RawMessage `json:"data"`
+}
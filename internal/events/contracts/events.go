@@ -0,0 +1,63 @@
+package contracts
+
+import "embed"
+
+const (
+	CityCreatedEvent                  = "city.created"
+	CityUpdatedEvent                  = "city.admin.update"
+	CityUpdatedStatusSupportedEvent   = "city.update.status.supported"
+	CityUpdatedStatusSuspendedEvent   = "city.update.status.suspended"
+	CityUpdatedStatusUnsupportedEvent = "city.update.status.unsupported"
+	CityAdminCreatedEvent             = "city.admin.create"
+	CityAdminUpdatedEvent             = "city.admin.updated"
+	CityAdminDeletedEvent             = "city.admin.deleted"
+	InviteCreatedEvent                = "city.invite.create"
+	InviteAcceptedEvent               = "city.invite.accepted"
+	InviteDeclinedEvent               = "city.invite.decline"
+)
+
+// Payload versions, bump the version of an event whenever the JSON shape of
+// its data changes.
+const (
+	CityCreatedVersion       = "1"
+	CityUpdatedVersion       = "1"
+	CityStatusUpdatedVersion = "1"
+	CityAdminCreatedVersion  = "1"
+	CityAdminUpdatedVersion  = "1"
+	CityAdminDeletedVersion  = "1"
+	InviteCreatedVersion     = "1"
+	InviteAcceptedVersion    = "1"
+	InviteDeclinedVersion    = "1"
+)
+
+// Event describes a published event, Data is a zero value of its payload.
+type Event struct {
+	Name    string
+	Version string
+	Topic   string
+	Data    any
+}
+
+// Events lists every event the service publishes. The JSON Schema of each one
+// is committed under schemas/<topic>/<name>.v<version>.json.
+var Events = []Event{
+	{CityCreatedEvent, CityCreatedVersion, TopicCitiesV1, CityCreatedData{}},
+	{CityUpdatedEvent, CityUpdatedVersion, TopicCitiesAdminV1, CityUpdatedData{}},
+	{CityUpdatedStatusSupportedEvent, CityStatusUpdatedVersion, TopicCitiesAdminV1, CityStatusUpdatedData{}},
+	{CityUpdatedStatusSuspendedEvent, CityStatusUpdatedVersion, TopicCitiesAdminV1, CityStatusUpdatedData{}},
+	{CityUpdatedStatusUnsupportedEvent, CityStatusUpdatedVersion, TopicCitiesAdminV1, CityStatusUpdatedData{}},
+	{CityAdminCreatedEvent, CityAdminCreatedVersion, TopicCitiesAdminV1, CityAdminCreatedData{}},
+	{CityAdminUpdatedEvent, CityAdminUpdatedVersion, TopicCitiesAdminV1, CityAdminUpdatedData{}},
+	{CityAdminDeletedEvent, CityAdminDeletedVersion, TopicCitiesAdminV1, CityAdminDeletedData{}},
+	{InviteCreatedEvent, InviteCreatedVersion, TopicCitiesAdminV1, InviteCreatedData{}},
+	{InviteAcceptedEvent, InviteAcceptedVersion, TopicCitiesV1, InviteAcceptedData{}},
+	{InviteDeclinedEvent, InviteDeclinedVersion, TopicCitiesV1, InviteDeclinedData{}},
+}
+
+// SchemaPath is the path of the JSON Schema of the event within Schemas.
+func (e Event) SchemaPath() string {
+	return "schemas/" + e.Topic + "/" + e.Name + ".v" + e.Version + ".json"
+}
+
+//go:embed schemas
+var Schemas embed.FS
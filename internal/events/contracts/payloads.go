@@ -0,0 +1,100 @@
+package contracts
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// The types below are the wire format of the events, they are deliberately
+// separate from the domain models. Any change to their JSON shape has to come
+// with a version bump of every event that carries them, see Events.
+
+type City struct {
+	ID        uuid.UUID  `json:"id"`
+	CountryID string     `json:"country_id"`
+	Point     [2]float64 `json:"point"` // [lon, lat]
+	Status    string     `json:"status"`
+	Name      string     `json:"name"`
+	Icon      *string    `json:"icon,omitempty"`
+	Slug      *string    `json:"slug,omitempty"`
+	Timezone  string     `json:"timezone"`
+	CreatedAt time.Time  `json:"created_at"`
+	UpdatedAt time.Time  `json:"updated_at"`
+}
+
+type CityAdmin struct {
+	UserID    uuid.UUID `json:"user_id"`
+	CityID    uuid.UUID `json:"city_id"`
+	Role      string    `json:"role"`
+	Label     *string   `json:"label,omitempty"`
+	Position  *string   `json:"position,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Invite struct {
+	ID          uuid.UUID `json:"id"`
+	CityID      uuid.UUID `json:"city_id"`
+	UserID      uuid.UUID `json:"user_id"`
+	InitiatorID uuid.UUID `json:"initiator_id"`
+	Status      string    `json:"status"`
+	Role        string    `json:"role"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type Recipients struct {
+	Users []uuid.UUID `json:"users"`
+}
+
+type CityCreatedData struct {
+	City City `json:"city"`
+}
+
+type CityUpdatedData struct {
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type CityStatusUpdatedData struct {
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type CityAdminCreatedData struct {
+	City       City        `json:"city"`
+	Admin      CityAdmin   `json:"admin"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type CityAdminUpdatedData struct {
+	CityAdmin  CityAdmin   `json:"city_admin"`
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type CityAdminDeletedData struct {
+	CityAdmin  CityAdmin   `json:"city_admin"`
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type InviteCreatedData struct {
+	Invite     Invite      `json:"invite"`
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type InviteAcceptedData struct {
+	Invite     Invite      `json:"invite"`
+	City       City        `json:"city"`
+	CityAdmin  CityAdmin   `json:"city_admin"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
+
+type InviteDeclinedData struct {
+	Invite     Invite      `json:"invite"`
+	City       City        `json:"city"`
+	Recipients *Recipients `json:"recipients,omitempty"`
+}
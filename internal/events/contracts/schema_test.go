@@ -0,0 +1,174 @@
+package contracts
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var update = flag.Bool("update", false, "write the JSON Schemas of new event versions")
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+	uuidType = reflect.TypeOf(uuid.UUID{})
+)
+
+// TestSchemas fails when the payload of an event no longer matches the schema
+// committed for its version. Changing a payload requires a version bump, the
+// schema of the new version is written by running the test with -update.
+func TestSchemas(t *testing.T) {
+	seen := map[string]bool{}
+
+	for _, e := range Events {
+		t.Run(e.Name, func(t *testing.T) {
+			if seen[e.Name] {
+				t.Fatalf("event %s is listed twice", e.Name)
+			}
+			seen[e.Name] = true
+
+			got, err := json.MarshalIndent(envelopeSchema(e), "", "  ")
+			if err != nil {
+				t.Fatalf("marshal schema: %v", err)
+			}
+			got = append(got, '\n')
+
+			file := filepath.FromSlash(e.SchemaPath())
+			want, err := os.ReadFile(file)
+			switch {
+			case os.IsNotExist(err) && *update:
+				if err = os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+					t.Fatalf("create schema dir: %v", err)
+				}
+				if err = os.WriteFile(file, got, 0o644); err != nil {
+					t.Fatalf("write schema: %v", err)
+				}
+				return
+			case os.IsNotExist(err):
+				t.Fatalf("%s is missing, run the test with -update to write it", file)
+			case err != nil:
+				t.Fatalf("read schema: %v", err)
+			}
+
+			if !bytes.Equal(got, want) {
+				t.Errorf("payload of %s changed without a version bump, schema %s no longer matches:\n%s",
+					e.Name, file, got)
+			}
+		})
+	}
+}
+
+// TestSchemasAreListed catches schema files left behind for events which are
+// no longer published on that topic. Schemas of previous versions of an event
+// stay for consumers which still read them.
+func TestSchemasAreListed(t *testing.T) {
+	listed := map[string]bool{}
+	for _, e := range Events {
+		listed[path.Join("schemas", e.Topic, e.Name)] = true
+	}
+
+	err := fs.WalkDir(Schemas, "schemas", func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		name := path.Base(p)
+		name = name[:max(0, strings.LastIndex(name, ".v"))]
+		if !listed[path.Join(path.Dir(p), name)] {
+			t.Errorf("%s does not belong to any published event", p)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("walk schemas: %v", err)
+	}
+}
+
+func envelopeSchema(e Event) map[string]any {
+	return map[string]any{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"$id":     e.SchemaPath(),
+		"title":   e.Name,
+		"type":    "object",
+		"properties": map[string]any{
+			"events":    map[string]any{"const": e.Name},
+			"version":   map[string]any{"const": e.Version},
+			"timestamp": map[string]any{"type": "string", "format": "date-time"},
+			"data":      schemaOf(reflect.TypeOf(e.Data)),
+		},
+		"required":             []string{"events", "version", "timestamp", "data"},
+		"additionalProperties": false,
+	}
+}
+
+func schemaOf(t reflect.Type) map[string]any {
+	switch t {
+	case timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case uuidType:
+		return map[string]any{"type": "string", "format": "uuid"}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return schemaOf(t.Elem())
+	case reflect.String:
+		return map[string]any{"type": "string"}
+	case reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return map[string]any{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]any{"type": "number"}
+	case reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem())}
+	case reflect.Array:
+		return map[string]any{
+			"type":     "array",
+			"items":    schemaOf(t.Elem()),
+			"minItems": t.Len(),
+			"maxItems": t.Len(),
+		}
+	case reflect.Struct:
+		props := map[string]any{}
+		required := []string{}
+		for i := range t.NumField() {
+			f := t.Field(i)
+			name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if name == "-" || !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+
+			props[name] = schemaOf(f.Type)
+			if strings.Contains(opts, "omitempty") {
+				continue
+			}
+			if f.Type.Kind() == reflect.Pointer {
+				props[name] = map[string]any{"anyOf": []any{props[name], map[string]any{"type": "null"}}}
+			}
+			required = append(required, name)
+		}
+
+		return map[string]any{
+			"type":                 "object",
+			"properties":           props,
+			"required":             required,
+			"additionalProperties": false,
+		}
+	}
+
+	panic("contracts: no schema for " + t.String())
+}
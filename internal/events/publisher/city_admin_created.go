@@ -6,43 +6,45 @@ import (
 	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
-	events "github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
 	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
 )
 
-type CreatedCityAdminData struct {
-	City       models.City        `json:"city"`
-	Admin      models.CityAdmin   `json:"admin"`
-	Recipients *PayloadRecipients `json:"recipients,omitempty"`
-}
-
-const CityAdminCreatedEvent = "city.admin.create"
-
 func (s Service) PublishCityAdminCreated(
 	ctx context.Context,
 	admin models.CityAdmin,
 	city models.City,
 	recipients ...uuid.UUID,
 ) error {
-	event := events.Envelope[CreatedCityAdminData]{
-		Event:     CityAdminCreatedEvent,
-		Version:   "1",
+	return s.publishCityAdminCreated(ctx, contracts.TopicCitiesAdminV1, admin, city, recipients)
+}
+
+func (s Service) publishCityAdminCreated(
+	ctx context.Context,
+	topic string,
+	admin models.CityAdmin,
+	city models.City,
+	recipients []uuid.UUID,
+	headers ...kafka.Header,
+) error {
+	event := contracts.Envelope[contracts.CityAdminCreatedData]{
+		Event:     contracts.CityAdminCreatedEvent,
+		Version:   contracts.CityAdminCreatedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: CreatedCityAdminData{
-			City:  city,
-			Admin: admin,
+		Data: contracts.CityAdminCreatedData{
+			City:       cityData(city),
+			Admin:      cityAdminData(admin),
+			Recipients: recipientsData(recipients),
 		},
 	}
-	if len(recipients) > 0 {
-		event.Data.Recipients = &PayloadRecipients{
-			Users: recipients,
-		}
-	}
 
 	return s.publish(
 		ctx,
-		events.TopicCitiesAdminV1,
+		topic,
 		fmt.Sprintf("%s:%s", admin.UserID.String(), city.ID.String()),
+		city.ID,
 		event,
+		headers...,
 	)
 }
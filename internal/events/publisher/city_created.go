@@ -6,31 +6,37 @@ import (
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/segmentio/kafka-go"
 )
 
-type CityCreatedData struct {
-	City models.City `json:"city"`
+func (s Service) PublishCityCreated(
+	ctx context.Context,
+	city models.City,
+) error {
+	return s.publishCityCreated(ctx, contracts.TopicCitiesV1, city)
 }
 
-const CityCreatedEvent = "city.created"
-
-func (s Service) PublishCityCreated(
+func (s Service) publishCityCreated(
 	ctx context.Context,
+	topic string,
 	city models.City,
+	headers ...kafka.Header,
 ) error {
-	event := contracts.Envelope[CityCreatedData]{
-		Event:     CityCreatedEvent,
-		Version:   "1",
+	event := contracts.Envelope[contracts.CityCreatedData]{
+		Event:     contracts.CityCreatedEvent,
+		Version:   contracts.CityCreatedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: CityCreatedData{
-			City: city,
+		Data: contracts.CityCreatedData{
+			City: cityData(city),
 		},
 	}
 
 	return s.publish(
 		ctx,
-		contracts.TopicCitiesV1,
+		topic,
 		city.ID.String(),
+		city.ID,
 		event,
+		headers...,
 	)
 }
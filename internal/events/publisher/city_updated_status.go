@@ -6,19 +6,10 @@ import (
 
 	"github.com/chains-lab/cities-svc/internal/domain/enum"
 	"github.com/chains-lab/cities-svc/internal/domain/models"
-	events "github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
 	"github.com/google/uuid"
 )
 
-type UpdatedStatusStatusData struct {
-	City       models.City        `json:"city"`
-	Recipients *PayloadRecipients `json:"recipients,omitempty"`
-}
-
-const CityUpdatedStatusSupportedEvent = "city.update.status.supported"
-const CityUpdatedStatusSuspendedEvent = "city.update.status.suspended"
-const CityUpdatedStatusUnsupportedEvent = "city.update.status.unsupported"
-
 func (s Service) PublishCityUpdatedStatus(
 	ctx context.Context,
 	city models.City,
@@ -28,33 +19,30 @@ func (s Service) PublishCityUpdatedStatus(
 	var eventName string
 	switch status {
 	case enum.CityStatusSupported:
-		eventName = CityUpdatedStatusSupportedEvent
+		eventName = contracts.CityUpdatedStatusSupportedEvent
 	case enum.CityStatusSuspended:
-		eventName = CityUpdatedStatusSuspendedEvent
+		eventName = contracts.CityUpdatedStatusSuspendedEvent
 	case enum.CityStatusUnsupported:
-		eventName = CityUpdatedStatusUnsupportedEvent
+		eventName = contracts.CityUpdatedStatusUnsupportedEvent
 	default:
 		return enum.ErrorInvalidCityStatus
 	}
 
-	event := events.Envelope[UpdatedStatusStatusData]{
+	event := contracts.Envelope[contracts.CityStatusUpdatedData]{
 		Event:     eventName,
-		Version:   "1",
+		Version:   contracts.CityStatusUpdatedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: UpdatedStatusStatusData{
-			City: city,
+		Data: contracts.CityStatusUpdatedData{
+			City:       cityData(city),
+			Recipients: recipientsData(recipients),
 		},
 	}
-	if len(recipients) > 0 {
-		event.Data.Recipients = &PayloadRecipients{
-			Users: recipients,
-		}
-	}
 
 	return s.publish(
 		ctx,
-		events.TopicCitiesAdminV1,
+		contracts.TopicCitiesAdminV1,
 		city.ID.String(),
+		city.ID,
 		event,
 	)
 }
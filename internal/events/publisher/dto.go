@@ -0,0 +1,55 @@
+package publisher
+
+import (
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/google/uuid"
+)
+
+func cityData(m models.City) contracts.City {
+	return contracts.City{
+		ID:        m.ID,
+		CountryID: m.CountryID,
+		Point:     [2]float64{m.Point[0], m.Point[1]},
+		Status:    m.Status,
+		Name:      m.Name,
+		Icon:      m.Icon,
+		Slug:      m.Slug,
+		Timezone:  m.Timezone,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func cityAdminData(m models.CityAdmin) contracts.CityAdmin {
+	return contracts.CityAdmin{
+		UserID:    m.UserID,
+		CityID:    m.CityID,
+		Role:      m.Role,
+		Label:     m.Label,
+		Position:  m.Position,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func inviteData(m models.Invite) contracts.Invite {
+	return contracts.Invite{
+		ID:          m.ID,
+		CityID:      m.CityID,
+		UserID:      m.UserID,
+		InitiatorID: m.InitiatorID,
+		Status:      m.Status,
+		Role:        m.Role,
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func recipientsData(users []uuid.UUID) *contracts.Recipients {
+	if len(users) == 0 {
+		return nil
+	}
+
+	return &contracts.Recipients{Users: users}
+}
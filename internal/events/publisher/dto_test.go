@@ -0,0 +1,158 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/segmentio/kafka-go"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files")
+
+// capture takes the message of an event in BeforePublish and cancels the
+// write, so the publish stops before it reaches Kafka.
+type capture struct {
+	payload []byte
+}
+
+func (c *capture) BeforePublish(ctx context.Context, _ string, msg *kafka.Message) context.Context {
+	c.payload = msg.Value
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	return ctx
+}
+
+func (c *capture) AfterPublish(context.Context, string, error) {}
+
+var timestampRe = regexp.MustCompile(`"timestamp":"[^"]*"`)
+
+// TestPayloadGolden pins the JSON every Publish method builds from the domain
+// models, so a model change which leaks into a payload fails here.
+func TestPayloadGolden(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	slug := "kyiv"
+	label := "press"
+
+	city := models.City{
+		ID:        uuid.MustParse("11111111-1111-1111-1111-111111111111"),
+		CountryID: "UKR",
+		Point:     orb.Point{30.5234, 50.4501},
+		Status:    "supported",
+		Name:      "Kyiv",
+		Slug:      &slug,
+		Timezone:  "Europe/Kyiv",
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	admin := models.CityAdmin{
+		UserID:    uuid.MustParse("22222222-2222-2222-2222-222222222222"),
+		CityID:    city.ID,
+		Role:      "moderator",
+		Label:     &label,
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	invite := func(status string) models.Invite {
+		return models.Invite{
+			ID:          uuid.MustParse("33333333-3333-3333-3333-333333333333"),
+			CityID:      city.ID,
+			UserID:      admin.UserID,
+			InitiatorID: uuid.MustParse("44444444-4444-4444-4444-444444444444"),
+			Status:      status,
+			Role:        "moderator",
+			ExpiresAt:   ts.Add(24 * time.Hour),
+			CreatedAt:   ts,
+		}
+	}
+	recipients := []uuid.UUID{admin.UserID}
+
+	cases := map[string]func(s Service) error{
+		contracts.CityCreatedEvent: func(s Service) error {
+			return s.PublishCityCreated(context.Background(), city)
+		},
+		contracts.CityUpdatedEvent: func(s Service) error {
+			return s.PublishCityUpdated(context.Background(), city, recipients...)
+		},
+		contracts.CityUpdatedStatusSupportedEvent: func(s Service) error {
+			return s.PublishCityUpdatedStatus(context.Background(), city, enum.CityStatusSupported, recipients...)
+		},
+		contracts.CityUpdatedStatusSuspendedEvent: func(s Service) error {
+			c := city
+			c.Status = enum.CityStatusSuspended
+			return s.PublishCityUpdatedStatus(context.Background(), c, enum.CityStatusSuspended, recipients...)
+		},
+		contracts.CityUpdatedStatusUnsupportedEvent: func(s Service) error {
+			c := city
+			c.Status = enum.CityStatusUnsupported
+			return s.PublishCityUpdatedStatus(context.Background(), c, enum.CityStatusUnsupported)
+		},
+		contracts.CityAdminCreatedEvent: func(s Service) error {
+			return s.PublishCityAdminCreated(context.Background(), admin, city, recipients...)
+		},
+		contracts.CityAdminUpdatedEvent: func(s Service) error {
+			return s.PublishCityAdminUpdated(context.Background(), admin, city, recipients...)
+		},
+		contracts.CityAdminDeletedEvent: func(s Service) error {
+			return s.PublishCityAdminDeleted(context.Background(), admin, city, recipients...)
+		},
+		contracts.InviteCreatedEvent: func(s Service) error {
+			return s.PublishInviteCreated(context.Background(), invite("sent"), city, recipients...)
+		},
+		contracts.InviteAcceptedEvent: func(s Service) error {
+			return s.PublishInviteAccepted(context.Background(), invite("accepted"), city, admin, recipients...)
+		},
+		contracts.InviteDeclinedEvent: func(s Service) error {
+			return s.PublishInviteDeclined(context.Background(), invite("declined"), city, invite("declined").InitiatorID)
+		},
+	}
+
+	for _, e := range contracts.Events {
+		t.Run(e.Name+".v"+e.Version, func(t *testing.T) {
+			publish, ok := cases[e.Name]
+			if !ok {
+				t.Fatalf("no golden case for %s", e.Name)
+			}
+
+			c := &capture{}
+			if err := publish(Service{hooks: []PublishHook{c}}); err == nil {
+				t.Fatal("publish reached Kafka")
+			}
+			body := timestampRe.ReplaceAll(c.payload, []byte(`"timestamp":"`+ts.Format(time.RFC3339)+`"`))
+
+			var got bytes.Buffer
+			if err := json.Indent(&got, body, "", "  "); err != nil {
+				t.Fatalf("indent: %v", err)
+			}
+			got.WriteByte('\n')
+
+			file := filepath.Join("testdata", e.Name+".v"+e.Version+".json")
+			if *update {
+				if err := os.WriteFile(file, got.Bytes(), 0o644); err != nil {
+					t.Fatalf("write golden: %v", err)
+				}
+				return
+			}
+
+			want, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatalf("read golden: %v", err)
+			}
+			if !bytes.Equal(got.Bytes(), want) {
+				t.Errorf("payload differs from %s:\n%s", file, got.String())
+			}
+		})
+	}
+}
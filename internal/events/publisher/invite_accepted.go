@@ -9,15 +9,6 @@ import (
 	"github.com/google/uuid"
 )
 
-type InviteAcceptedPayload struct {
-	Invite     models.Invite      `json:"invite"`
-	City       models.City        `json:"city"`
-	CityAdmin  models.CityAdmin   `json:"city_admin"`
-	Recipients *PayloadRecipients `json:"recipients,omitempty"`
-}
-
-const InviteAcceptedEvent = "city.invite.accepted"
-
 func (s Service) PublishInviteAccepted(
 	ctx context.Context,
 	invite models.Invite,
@@ -25,26 +16,23 @@ func (s Service) PublishInviteAccepted(
 	cityAdmin models.CityAdmin,
 	recipients ...uuid.UUID,
 ) error {
-	event := contracts.Envelope[InviteAcceptedPayload]{
-		Event:     InviteAcceptedEvent,
-		Version:   "1",
+	event := contracts.Envelope[contracts.InviteAcceptedData]{
+		Event:     contracts.InviteAcceptedEvent,
+		Version:   contracts.InviteAcceptedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: InviteAcceptedPayload{
-			City:      city,
-			Invite:    invite,
-			CityAdmin: cityAdmin,
+		Data: contracts.InviteAcceptedData{
+			City:       cityData(city),
+			Invite:     inviteData(invite),
+			CityAdmin:  cityAdminData(cityAdmin),
+			Recipients: recipientsData(recipients),
 		},
 	}
-	if len(recipients) > 0 {
-		event.Data.Recipients = &PayloadRecipients{
-			Users: recipients,
-		}
-	}
 
 	return s.publish(
 		ctx,
 		contracts.TopicCitiesV1,
 		invite.ID.String(),
+		city.ID,
 		event,
 	)
 }
@@ -5,43 +5,32 @@ import (
 	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
-	events "github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
 	"github.com/google/uuid"
 )
 
-type InviteCreatedData struct {
-	Invite     models.Invite      `json:"invite"`
-	City       models.City        `json:"city"`
-	Recipients *PayloadRecipients `json:"recipients,omitempty"`
-}
-
-const InviteCreatedEvent = "city.invite.create"
-
 func (s Service) PublishInviteCreated(
 	ctx context.Context,
 	invite models.Invite,
 	city models.City,
 	recipients ...uuid.UUID,
 ) error {
-	event := events.Envelope[InviteCreatedData]{
-		Event:     InviteCreatedEvent,
-		Version:   "1",
+	event := contracts.Envelope[contracts.InviteCreatedData]{
+		Event:     contracts.InviteCreatedEvent,
+		Version:   contracts.InviteCreatedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: InviteCreatedData{
-			Invite: invite,
-			City:   city,
+		Data: contracts.InviteCreatedData{
+			Invite:     inviteData(invite),
+			City:       cityData(city),
+			Recipients: recipientsData(recipients),
 		},
 	}
-	if len(recipients) > 0 {
-		event.Data.Recipients = &PayloadRecipients{
-			Users: recipients,
-		}
-	}
 
 	return s.publish(
 		ctx,
-		events.TopicCitiesAdminV1,
+		contracts.TopicCitiesAdminV1,
 		invite.ID.String(),
+		city.ID,
 		event,
 	)
 }
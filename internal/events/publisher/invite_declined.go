@@ -9,39 +9,28 @@ import (
 	"github.com/google/uuid"
 )
 
-type InviteDeclinedData struct {
-	Invite     models.Invite      `json:"invite"`
-	City       models.City        `json:"city"`
-	Recipients *PayloadRecipients `json:"recipients,omitempty"`
-}
-
-const InviteCanceledEvent = "city.invite.decline"
-
 func (s Service) PublishInviteDeclined(
 	ctx context.Context,
 	invite models.Invite,
 	city models.City,
 	recipients ...uuid.UUID,
 ) error {
-	event := contracts.Envelope[InviteDeclinedData]{
-		Event:     InviteCanceledEvent,
-		Version:   "1",
+	event := contracts.Envelope[contracts.InviteDeclinedData]{
+		Event:     contracts.InviteDeclinedEvent,
+		Version:   contracts.InviteDeclinedVersion,
 		Timestamp: time.Now().UTC(),
-		Data: InviteDeclinedData{
-			Invite: invite,
-			City:   city,
+		Data: contracts.InviteDeclinedData{
+			Invite:     inviteData(invite),
+			City:       cityData(city),
+			Recipients: recipientsData(recipients),
 		},
 	}
-	if len(recipients) > 0 {
-		event.Data.Recipients = &PayloadRecipients{
-			Users: recipients,
-		}
-	}
 
 	return s.publish(
 		ctx,
 		contracts.TopicCitiesV1,
 		invite.ID.String(),
+		city.ID,
 		event,
 	)
 }
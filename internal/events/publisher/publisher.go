@@ -2,40 +2,92 @@ package publisher
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"slices"
 	"time"
 
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/chains-lab/cities-svc/internal/requestid"
 	"github.com/google/uuid"
 	"github.com/segmentio/kafka-go"
 )
 
+// Message formats. Legacy is the contracts.Envelope body, the CloudEvents
+// formats follow the structured and binary modes of the Kafka protocol binding.
+const (
+	FormatLegacy                = "legacy"
+	FormatCloudEventsStructured = "cloudevents-structured"
+	FormatCloudEventsBinary     = "cloudevents-binary"
+)
+
 type Service struct {
-	addr string
+	addr        string
+	format      string
+	webhooks    Webhooks
+	broadcaster Broadcaster
+	hooks       []PublishHook
 }
 
-func New(addr string) *Service {
+// New builds a publisher, webhooks and broadcaster may be nil when they are
+// not used.
+func New(addr, format string, webhooks Webhooks, broadcaster Broadcaster, hooks ...PublishHook) *Service {
 	return &Service{
-		addr: addr,
+		addr:        addr,
+		format:      format,
+		webhooks:    webhooks,
+		broadcaster: broadcaster,
+		hooks:       hooks,
 	}
 }
 
+// Webhooks receives every event written to Kafka except replays, with the
+// legacy envelope as payload, for delivery to webhook subscribers.
+type Webhooks interface {
+	Enqueue(ctx context.Context, eventID uuid.UUID, eventType string, payload []byte) error
+}
+
+// Broadcaster receives the data of every event written to Kafka except replays,
+// for streaming the changes of the city to connected dashboards.
+type Broadcaster interface {
+	Broadcast(cityID uuid.UUID, eventType string, data []byte)
+}
+
+// PublishHook is notified around every message written to Kafka.
+// BeforePublish may modify the message (e.g. add headers) and return a derived context.
+type PublishHook interface {
+	BeforePublish(ctx context.Context, topic string, msg *kafka.Message) context.Context
+	AfterPublish(ctx context.Context, topic string, err error)
+}
+
 type Envelope interface {
 	MarshalJSON() ([]byte, error)
 	EventType() string
 	EventVersion() string
 	EventTime() time.Time
+	EventData() interface{}
 }
 
 func (s Service) publish(
 	ctx context.Context,
 	topic, key string,
+	cityID uuid.UUID,
 	envelope Envelope,
 	headers ...kafka.Header,
 ) error {
-	body, err := envelope.MarshalJSON()
+	id := uuid.New()
+
+	msg, err := s.message(id, key, citySubject(cityID), envelope)
 	if err != nil {
 		return err
 	}
+	msg.Headers = append(headers, msg.Headers...)
+	if requestID := requestid.From(ctx); requestID != "" {
+		msg.Headers = append(msg.Headers, kafka.Header{Key: "request_id", Value: []byte(requestID)})
+	}
+
+	replay := slices.ContainsFunc(headers, isReplay)
 
 	writer := kafka.Writer{
 		Addr:         kafka.TCP(s.addr),
@@ -51,20 +103,115 @@ func (s Service) publish(
 		}
 	}()
 
+	writeCtx := ctx
+	for _, h := range s.hooks {
+		writeCtx = h.BeforePublish(writeCtx, topic, &msg)
+	}
+
+	err = writer.WriteMessages(writeCtx, msg)
+
+	for i := len(s.hooks) - 1; i >= 0; i-- {
+		s.hooks[i].AfterPublish(writeCtx, topic, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	// partners and dashboards only hear about events which made it to Kafka
+	if s.broadcaster != nil && !replay {
+		data, err := json.Marshal(envelope.EventData())
+		if err != nil {
+			return err
+		}
+		s.broadcaster.Broadcast(cityID, envelope.EventType(), data)
+	}
+	if s.webhooks != nil && !replay {
+		payload, err := envelope.MarshalJSON()
+		if err != nil {
+			return err
+		}
+		if err = s.webhooks.Enqueue(ctx, id, envelope.EventType(), payload); err != nil {
+			return fmt.Errorf("enqueue webhook deliveries: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// message lays the envelope out in the configured format. Every message gets a
+// unique event id and keeps the event_type and event_version headers, so
+// consumers can route and deduplicate regardless of the format during the
+// migration to CloudEvents.
+func (s Service) message(id uuid.UUID, key, subject string, envelope Envelope) (kafka.Message, error) {
 	msg := kafka.Message{
-		Key:   []byte(key),
-		Value: body,
-		Time:  envelope.EventTime(),
-		Headers: append(headers,
-			kafka.Header{Key: "event_type", Value: []byte(envelope.EventType())},
-			kafka.Header{Key: "event_version", Value: []byte(envelope.EventVersion())},
-			kafka.Header{Key: "content_type", Value: []byte("application/json")},
-		),
+		Key:  []byte(key),
+		Time: envelope.EventTime(),
+		Headers: []kafka.Header{
+			{Key: "event_id", Value: []byte(id.String())},
+			{Key: "event_type", Value: []byte(envelope.EventType())},
+			{Key: "event_version", Value: []byte(envelope.EventVersion())},
+		},
 	}
 
-	return writer.WriteMessages(ctx, msg)
+	switch s.format {
+	case FormatLegacy, "":
+		body, err := envelope.MarshalJSON()
+		if err != nil {
+			return kafka.Message{}, err
+		}
+		msg.Value = body
+		msg.Headers = append(msg.Headers, kafka.Header{Key: "content_type", Value: []byte("application/json")})
+
+	case FormatCloudEventsStructured:
+		data, err := json.Marshal(envelope.EventData())
+		if err != nil {
+			return kafka.Message{}, err
+		}
+		body, err := json.Marshal(contracts.CloudEvent{
+			SpecVersion:     contracts.CloudEventsSpecVersion,
+			ID:              id.String(),
+			Source:          contracts.CloudEventsSource,
+			Type:            envelope.EventType(),
+			Subject:         subject,
+			Time:            envelope.EventTime(),
+			DataContentType: "application/json",
+			DataVersion:     envelope.EventVersion(),
+			Data:            data,
+		})
+		if err != nil {
+			return kafka.Message{}, err
+		}
+		msg.Value = body
+		msg.Headers = append(msg.Headers, kafka.Header{Key: "content-type", Value: []byte("application/cloudevents+json")})
+
+	case FormatCloudEventsBinary:
+		data, err := json.Marshal(envelope.EventData())
+		if err != nil {
+			return kafka.Message{}, err
+		}
+		msg.Value = data
+		msg.Headers = append(msg.Headers,
+			kafka.Header{Key: "ce_specversion", Value: []byte(contracts.CloudEventsSpecVersion)},
+			kafka.Header{Key: "ce_id", Value: []byte(id.String())},
+			kafka.Header{Key: "ce_source", Value: []byte(contracts.CloudEventsSource)},
+			kafka.Header{Key: "ce_type", Value: []byte(envelope.EventType())},
+			kafka.Header{Key: "ce_subject", Value: []byte(subject)},
+			kafka.Header{Key: "ce_time", Value: []byte(envelope.EventTime().Format(time.RFC3339Nano))},
+			kafka.Header{Key: "ce_dataversion", Value: []byte(envelope.EventVersion())},
+			kafka.Header{Key: "content-type", Value: []byte("application/json")},
+		)
+
+	default:
+		return kafka.Message{}, fmt.Errorf("unknown message format %q", s.format)
+	}
+
+	return msg, nil
+}
+
+func isReplay(h kafka.Header) bool {
+	return h.Key == ReplayHeader.Key
 }
 
-type PayloadRecipients struct {
-	Users []uuid.UUID `json:"users"`
+func citySubject(cityID uuid.UUID) string {
+	return "city/" + cityID.String()
 }
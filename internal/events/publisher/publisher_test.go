@@ -0,0 +1,168 @@
+package publisher
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/google/uuid"
+	"github.com/segmentio/kafka-go"
+)
+
+func TestMessage(t *testing.T) {
+	ts := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	cityID := uuid.MustParse("11111111-1111-1111-1111-111111111111")
+	subject := citySubject(cityID)
+	id := uuid.New()
+
+	envelope := contracts.Envelope[contracts.CityCreatedData]{
+		Event:     contracts.CityCreatedEvent,
+		Version:   contracts.CityCreatedVersion,
+		Timestamp: ts,
+		Data:      contracts.CityCreatedData{City: contracts.City{ID: cityID, Name: "Kyiv"}},
+	}
+	data, err := json.Marshal(envelope.Data)
+	if err != nil {
+		t.Fatalf("marshal data: %v", err)
+	}
+	legacy, err := envelope.MarshalJSON()
+	if err != nil {
+		t.Fatalf("marshal envelope: %v", err)
+	}
+
+	t.Run(FormatLegacy, func(t *testing.T) {
+		msg, err := Service{format: FormatLegacy}.message(id, cityID.String(), subject, envelope)
+		if err != nil {
+			t.Fatalf("message: %v", err)
+		}
+
+		if string(msg.Value) != string(legacy) {
+			t.Errorf("value = %s, want %s", msg.Value, legacy)
+		}
+		wantHeaders(t, msg, map[string]string{
+			"event_type":    contracts.CityCreatedEvent,
+			"event_version": contracts.CityCreatedVersion,
+			"content_type":  "application/json",
+		})
+		if got := header(msg, "event_id"); got != id.String() {
+			t.Errorf("event_id = %q, want %s", got, id)
+		}
+	})
+
+	t.Run(FormatCloudEventsStructured, func(t *testing.T) {
+		msg, err := Service{format: FormatCloudEventsStructured}.message(id, cityID.String(), subject, envelope)
+		if err != nil {
+			t.Fatalf("message: %v", err)
+		}
+
+		var got contracts.CloudEvent
+		if err = json.Unmarshal(msg.Value, &got); err != nil {
+			t.Fatalf("unmarshal: %v", err)
+		}
+		want := contracts.CloudEvent{
+			SpecVersion:     "1.0",
+			ID:              id.String(),
+			Source:          "cities-svc",
+			Type:            contracts.CityCreatedEvent,
+			Subject:         "city/" + cityID.String(),
+			Time:            ts,
+			DataContentType: "application/json",
+			DataVersion:     contracts.CityCreatedVersion,
+			Data:            data,
+		}
+		if string(got.Data) != string(want.Data) {
+			t.Errorf("data = %s, want %s", got.Data, want.Data)
+		}
+		got.Data, want.Data = nil, nil
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("event = %+v, want %+v", got, want)
+		}
+		wantHeaders(t, msg, map[string]string{"content-type": "application/cloudevents+json"})
+	})
+
+	t.Run(FormatCloudEventsBinary, func(t *testing.T) {
+		msg, err := Service{format: FormatCloudEventsBinary}.message(id, cityID.String(), subject, envelope)
+		if err != nil {
+			t.Fatalf("message: %v", err)
+		}
+
+		if string(msg.Value) != string(data) {
+			t.Errorf("value = %s, want %s", msg.Value, data)
+		}
+		wantHeaders(t, msg, map[string]string{
+			"ce_specversion": "1.0",
+			"ce_id":          id.String(),
+			"ce_source":      "cities-svc",
+			"ce_type":        contracts.CityCreatedEvent,
+			"ce_subject":     "city/" + cityID.String(),
+			"ce_time":        "2025-01-02T03:04:05Z",
+			"ce_dataversion": contracts.CityCreatedVersion,
+			"content-type":   "application/json",
+		})
+	})
+
+	t.Run("unknown format", func(t *testing.T) {
+		if _, err := (Service{format: "avro"}).message(id, cityID.String(), subject, envelope); err == nil {
+			t.Error("expected an error")
+		}
+	})
+}
+
+func header(msg kafka.Message, key string) string {
+	for _, h := range msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func wantHeaders(t *testing.T, msg kafka.Message, want map[string]string) {
+	t.Helper()
+
+	for key, value := range want {
+		if got := header(msg, key); got != value {
+			t.Errorf("header %s = %q, want %q", key, got, value)
+		}
+	}
+}
+
+// enqueued records the events handed to webhooks.
+type enqueued struct {
+	events []string
+}
+
+func (e *enqueued) Enqueue(_ context.Context, _ uuid.UUID, eventType string, _ []byte) error {
+	e.events = append(e.events, eventType)
+	return nil
+}
+
+// broadcasted records the events pushed to dashboards.
+type broadcasted struct {
+	events []string
+}
+
+func (b *broadcasted) Broadcast(_ uuid.UUID, eventType string, _ []byte) {
+	b.events = append(b.events, eventType)
+}
+
+func TestFailedWriteSkipsSubscribers(t *testing.T) {
+	webhooks := &enqueued{}
+	broadcaster := &broadcasted{}
+	s := Service{webhooks: webhooks, broadcaster: broadcaster, hooks: []PublishHook{&capture{}}}
+
+	err := s.PublishCityCreated(context.Background(), models.City{ID: uuid.New(), Name: "Kyiv"})
+	if err == nil {
+		t.Fatal("publish reached Kafka")
+	}
+	if len(webhooks.events) != 0 {
+		t.Errorf("enqueued %v for an event Kafka never got", webhooks.events)
+	}
+	if len(broadcaster.events) != 0 {
+		t.Errorf("broadcast %v for an event Kafka never got", broadcaster.events)
+	}
+}
@@ -0,0 +1,43 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/segmentio/kafka-go"
+)
+
+// ReplayHeader marks snapshot events re-emitted for consumers rebuilding their
+// state, so they can tell them apart from live changes.
+var ReplayHeader = kafka.Header{Key: "replay", Value: []byte("true")}
+
+// ReplayCityCreated re-emits a city.created snapshot of the city. An empty
+// topic keeps the topic the event is normally published to.
+func (s Service) ReplayCityCreated(
+	ctx context.Context,
+	topic string,
+	city models.City,
+) error {
+	if topic == "" {
+		topic = contracts.TopicCitiesV1
+	}
+
+	return s.publishCityCreated(ctx, topic, city, ReplayHeader)
+}
+
+// ReplayCityAdminCreated re-emits a city.admin.created snapshot of a current
+// admin, without recipients. An empty topic keeps the topic the event is
+// normally published to.
+func (s Service) ReplayCityAdminCreated(
+	ctx context.Context,
+	topic string,
+	admin models.CityAdmin,
+	city models.City,
+) error {
+	if topic == "" {
+		topic = contracts.TopicCitiesAdminV1
+	}
+
+	return s.publishCityAdminCreated(ctx, topic, admin, city, nil, ReplayHeader)
+}
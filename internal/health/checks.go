@@ -0,0 +1,37 @@
+package health
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func Postgres(db *sql.DB) Check {
+	return Check{
+		Name: "postgres",
+		Fn: func(ctx context.Context) error {
+			return db.PingContext(ctx)
+		},
+	}
+}
+
+func Kafka(addr string) Check {
+	return Check{
+		Name: "kafka",
+		Fn: func(ctx context.Context) error {
+			conn, err := (&kafka.Dialer{}).DialContext(ctx, "tcp", addr)
+			if err != nil {
+				return fmt.Errorf("dial broker %s: %w", addr, err)
+			}
+			defer conn.Close()
+
+			if _, err = conn.Brokers(); err != nil {
+				return fmt.Errorf("list brokers: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,129 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Check is a named readiness probe of a single dependency.
+type Check struct {
+	Name string
+	Fn   func(ctx context.Context) error
+}
+
+type Service struct {
+	checks   []Check
+	timeout  time.Duration
+	draining atomic.Bool
+}
+
+func New(timeout time.Duration, checks ...Check) *Service {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	return &Service{
+		checks:  checks,
+		timeout: timeout,
+	}
+}
+
+// SetNotReady makes every following readiness probe fail, it is called when
+// graceful shutdown starts so that the instance is removed from load balancing.
+func (s *Service) SetNotReady() {
+	s.draining.Store(true)
+}
+
+type CheckResult struct {
+	Status    string `json:"status"`
+	LatencyMS int64  `json:"latency_ms"`
+	Error     string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+// Liveness reports that the process is up and serving HTTP.
+func (s *Service) Liveness(w http.ResponseWriter, r *http.Request) {
+	render(w, http.StatusOK, Report{Status: StatusOK})
+}
+
+// Readiness runs every dependency check concurrently and fails if any of them
+// fails or if the service is shutting down.
+func (s *Service) Readiness(w http.ResponseWriter, r *http.Request) {
+	if s.draining.Load() {
+		render(w, http.StatusServiceUnavailable, Report{Status: "shutting_down"})
+		return
+	}
+
+	report := s.Check(r.Context())
+
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	render(w, status, report)
+}
+
+func (s *Service) Check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var (
+		mu  sync.Mutex
+		wg  sync.WaitGroup
+		res = Report{
+			Status: StatusOK,
+			Checks: make(map[string]CheckResult, len(s.checks)),
+		}
+	)
+
+	for _, c := range s.checks {
+		wg.Add(1)
+		go func(c Check) {
+			defer wg.Done()
+
+			start := time.Now()
+			err := c.Fn(ctx)
+
+			cr := CheckResult{
+				Status:    StatusOK,
+				LatencyMS: time.Since(start).Milliseconds(),
+			}
+			if err != nil {
+				cr.Status = StatusFail
+				cr.Error = err.Error()
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			res.Checks[c.Name] = cr
+			if err != nil {
+				res.Status = StatusFail
+			}
+		}(c)
+	}
+
+	wg.Wait()
+
+	return res
+}
+
+func render(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,85 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func ok(name string) Check {
+	return Check{Name: name, Fn: func(context.Context) error { return nil }}
+}
+
+func readiness(t *testing.T, s *Service) (int, Report) {
+	t.Helper()
+
+	w := httptest.NewRecorder()
+	s.Readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var report Report
+	if err := json.NewDecoder(w.Body).Decode(&report); err != nil {
+		t.Fatalf("decode report: %v", err)
+	}
+
+	return w.Code, report
+}
+
+func TestReadiness(t *testing.T) {
+	code, report := readiness(t, New(time.Second, ok("postgres"), ok("kafka")))
+	if code != http.StatusOK || report.Status != StatusOK || len(report.Checks) != 2 {
+		t.Errorf("healthy = %d %+v", code, report)
+	}
+
+	failing := Check{Name: "kafka", Fn: func(context.Context) error { return errors.New("broker down") }}
+
+	code, report = readiness(t, New(time.Second, ok("postgres"), failing))
+	if code != http.StatusServiceUnavailable || report.Status != StatusFail {
+		t.Errorf("failing = %d %+v", code, report)
+	}
+	if c := report.Checks["postgres"]; c.Status != StatusOK || c.Error != "" {
+		t.Errorf("postgres = %+v", c)
+	}
+	if c := report.Checks["kafka"]; c.Status != StatusFail || c.Error != "broker down" {
+		t.Errorf("kafka = %+v", c)
+	}
+}
+
+func TestCheckTimeout(t *testing.T) {
+	stuck := Check{Name: "postgres", Fn: func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}}
+
+	start := time.Now()
+	report := New(50*time.Millisecond, stuck, ok("kafka")).Check(context.Background())
+
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Check took %s", elapsed)
+	}
+	if report.Status != StatusFail || report.Checks["postgres"].Error != context.DeadlineExceeded.Error() {
+		t.Errorf("report = %+v", report)
+	}
+	if report.Checks["kafka"].Status != StatusOK {
+		t.Errorf("kafka = %+v", report.Checks["kafka"])
+	}
+}
+
+func TestSetNotReady(t *testing.T) {
+	s := New(time.Second, ok("postgres"))
+	s.SetNotReady()
+
+	code, report := readiness(t, s)
+	if code != http.StatusServiceUnavailable || report.Status != "shutting_down" {
+		t.Errorf("draining = %d %+v", code, report)
+	}
+
+	w := httptest.NewRecorder()
+	s.Liveness(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("liveness while draining = %d", w.Code)
+	}
+}
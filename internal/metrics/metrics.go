@@ -0,0 +1,139 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/segmentio/kafka-go"
+)
+
+const namespace = "cities_svc"
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+
+	dbQueries  *prometheus.CounterVec
+	dbDuration *prometheus.HistogramVec
+
+	kafkaPublished *prometheus.CounterVec
+}
+
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of handled HTTP requests by route pattern.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "HTTP request latency by route pattern.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+
+		dbQueries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "queries_total",
+			Help:      "Number of executed SQL statements by table and operation.",
+		}, []string{"table", "op", "result"}),
+		dbDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "db",
+			Name:      "query_duration_seconds",
+			Help:      "SQL statement latency by table and operation.",
+			Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+		}, []string{"table", "op"}),
+
+		kafkaPublished: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "kafka",
+			Name:      "published_total",
+			Help:      "Number of messages published to Kafka by topic and result.",
+		}, []string{"topic", "result"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.dbQueries,
+		m.dbDuration,
+		m.kafkaPublished,
+	)
+
+	return m
+}
+
+// Handler serves the registry in the Prometheus exposition format.
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{Registry: m.registry})
+}
+
+// RegisterDBStats exports sql.DB connection pool stats under the given name.
+func (m *Metrics) RegisterDBStats(db *sql.DB, name string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, name))
+}
+
+func (m *Metrics) ObserveHTTPRequest(method, route string, status int, duration time.Duration) {
+	if route == "" {
+		route = "unmatched"
+	}
+
+	m.httpRequests.WithLabelValues(method, route, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(method, route).Observe(duration.Seconds())
+}
+
+type queryStartKey struct{}
+
+// BeforeQuery implements pgdb.QueryHook.
+func (m *Metrics) BeforeQuery(ctx context.Context, _, _ string) context.Context {
+	return context.WithValue(ctx, queryStartKey{}, time.Now())
+}
+
+// AfterQuery implements pgdb.QueryHook.
+func (m *Metrics) AfterQuery(ctx context.Context, table, op string, err error) {
+	if start, ok := ctx.Value(queryStartKey{}).(time.Time); ok {
+		m.dbDuration.WithLabelValues(table, op).Observe(time.Since(start).Seconds())
+	}
+
+	m.dbQueries.WithLabelValues(table, op, result(err, sql.ErrNoRows)).Inc()
+}
+
+// BeforePublish implements publisher.PublishHook.
+func (m *Metrics) BeforePublish(ctx context.Context, _ string, _ *kafka.Message) context.Context {
+	return ctx
+}
+
+// AfterPublish implements publisher.PublishHook.
+func (m *Metrics) AfterPublish(_ context.Context, topic string, err error) {
+	m.kafkaPublished.WithLabelValues(topic, result(err)).Inc()
+}
+
+func result(err error, ignore ...error) string {
+	if err == nil {
+		return "success"
+	}
+	for _, e := range ignore {
+		if errors.Is(err, e) {
+			return "success"
+		}
+	}
+	return "failure"
+}
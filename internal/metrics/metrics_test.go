@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestObserveHTTPRequest(t *testing.T) {
+	m := New()
+
+	m.ObserveHTTPRequest(http.MethodGet, "/cities-svc/v1/cities/{city_id}", http.StatusOK, 10*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodGet, "/cities-svc/v1/cities/{city_id}", http.StatusOK, 20*time.Millisecond)
+	m.ObserveHTTPRequest(http.MethodGet, "", http.StatusNotFound, time.Millisecond)
+
+	if got := testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/cities-svc/v1/cities/{city_id}", "200")); got != 2 {
+		t.Errorf("requests to city route = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "unmatched", "404")); got != 1 {
+		t.Errorf("unmatched requests = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.httpDuration); got != 2 {
+		t.Errorf("duration series = %d, want 2", got)
+	}
+}
+
+func TestObserveQueries(t *testing.T) {
+	m := New()
+
+	for _, err := range []error{nil, sql.ErrNoRows, errors.New("connection reset")} {
+		ctx := m.BeforeQuery(context.Background(), "cities", "select")
+		m.AfterQuery(ctx, "cities", "select", err)
+	}
+	m.AfterQuery(m.BeforeQuery(context.Background(), "tx", "transaction"), "tx", "transaction", nil)
+
+	if got := testutil.ToFloat64(m.dbQueries.WithLabelValues("cities", "select", "success")); got != 2 {
+		t.Errorf("successful selects = %v, want 2", got)
+	}
+	if got := testutil.ToFloat64(m.dbQueries.WithLabelValues("cities", "select", "failure")); got != 1 {
+		t.Errorf("failed selects = %v, want 1", got)
+	}
+	if got := testutil.CollectAndCount(m.dbDuration); got != 2 {
+		t.Errorf("duration series = %d, want 2", got)
+	}
+}
+
+func TestObservePublish(t *testing.T) {
+	m := New()
+
+	m.AfterPublish(m.BeforePublish(context.Background(), "cities.v1", nil), "cities.v1", nil)
+	m.AfterPublish(context.Background(), "cities.v1", errors.New("broker down"))
+
+	if got := testutil.ToFloat64(m.kafkaPublished.WithLabelValues("cities.v1", "success")); got != 1 {
+		t.Errorf("published = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(m.kafkaPublished.WithLabelValues("cities.v1", "failure")); got != 1 {
+		t.Errorf("failed publishes = %v, want 1", got)
+	}
+}
+
+func TestHandler(t *testing.T) {
+	m := New()
+	m.ObserveHTTPRequest(http.MethodPost, "/cities-svc/v1/cities", http.StatusCreated, time.Millisecond)
+
+	w := httptest.NewRecorder()
+	m.Handler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	want := `cities_svc_http_requests_total{method="POST",route="/cities-svc/v1/cities",status="201"} 1`
+	if !strings.Contains(w.Body.String(), want) {
+		t.Errorf("exposition does not contain %s", want)
+	}
+}
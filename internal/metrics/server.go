@@ -0,0 +1,50 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/logium"
+)
+
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m *Metrics) {
+	mux := http.NewServeMux()
+	mux.Handle(cfg.Metrics.Path, m.Handler())
+
+	srv := &http.Server{
+		Addr:              cfg.Metrics.Port,
+		Handler:           mux,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	log.Infof("starting metrics service on %s%s", cfg.Metrics.Port, cfg.Metrics.Path)
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		} else {
+			errCh <- nil
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Info("shutting down metrics service...")
+	case err := <-errCh:
+		if err != nil {
+			log.Errorf("metrics server error: %v", err)
+		}
+	}
+
+	shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shCtx); err != nil {
+		log.Errorf("metrics shutdown error: %v", err)
+	} else {
+		log.Info("metrics server stopped")
+	}
+}
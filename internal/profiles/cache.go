@@ -0,0 +1,67 @@
+package profiles
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type cacheEntry struct {
+	profile   Profile
+	expiresAt time.Time
+}
+
+type cache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[uuid.UUID]cacheEntry
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: make(map[uuid.UUID]cacheEntry),
+	}
+}
+
+func (c *cache) get(userID uuid.UUID) (Profile, bool) {
+	if c.ttl <= 0 {
+		return Profile{}, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[userID]
+	if !ok {
+		return Profile{}, false
+	}
+	if time.Now().After(e.expiresAt) {
+		delete(c.entries, userID)
+		return Profile{}, false
+	}
+
+	return e.profile, true
+}
+
+func (c *cache) set(p Profile) {
+	if c.ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	for id, e := range c.entries {
+		if now.After(e.expiresAt) {
+			delete(c.entries, id)
+		}
+	}
+
+	c.entries[p.UserID] = cacheEntry{
+		profile:   p,
+		expiresAt: now.Add(c.ttl),
+	}
+}
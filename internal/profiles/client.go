@@ -0,0 +1,221 @@
+package profiles
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var tracer = otel.Tracer("github.com/chains-lab/cities-svc/internal/profiles")
+
+var ErrNotFound = errors.New("profile not found")
+
+// maxParallel limits the number of concurrent requests made by GetMany.
+const maxParallel = 8
+
+type Profile struct {
+	UserID   uuid.UUID
+	Username string
+	Avatar   *string
+}
+
+// Client is an HTTP client of profiles-svc, successful lookups are cached for cacheTTL.
+type Client struct {
+	http    *http.Client
+	url     string
+	retries int
+	cache   *cache
+}
+
+func New(url string, timeout time.Duration, retries int, cacheTTL time.Duration) *Client {
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+	if retries < 0 {
+		retries = 0
+	}
+
+	return &Client{
+		http:    &http.Client{Timeout: timeout},
+		url:     strings.TrimSuffix(url, "/"),
+		retries: retries,
+		cache:   newCache(cacheTTL),
+	}
+}
+
+// Get returns the profile of the user, ErrNotFound is returned if the user does not exist.
+func (c *Client) Get(ctx context.Context, userID uuid.UUID) (Profile, error) {
+	if p, ok := c.cache.get(userID); ok {
+		return p, nil
+	}
+
+	var (
+		p   Profile
+		err error
+	)
+	for attempt := 0; attempt <= c.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return Profile{}, ctx.Err()
+			case <-time.After(backoff(attempt)):
+			}
+		}
+
+		p, err = c.fetch(ctx, userID)
+		if err == nil {
+			c.cache.set(p)
+			return p, nil
+		}
+
+		var rerr retryableError
+		if !errors.As(err, &rerr) {
+			return Profile{}, err
+		}
+	}
+
+	return Profile{}, fmt.Errorf("get profile %s after %d attempts: %w", userID, c.retries+1, err)
+}
+
+// GetMany returns the profiles of the users that exist, keyed by user id.
+func (c *Client) GetMany(ctx context.Context, userIDs ...uuid.UUID) (map[uuid.UUID]Profile, error) {
+	var (
+		mu   sync.Mutex
+		wg   sync.WaitGroup
+		res  = make(map[uuid.UUID]Profile, len(userIDs))
+		errs []error
+		seen = make(map[uuid.UUID]bool, len(userIDs))
+		sem  = make(chan struct{}, maxParallel)
+	)
+
+	for _, id := range userIDs {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		wg.Add(1)
+		go func(id uuid.UUID) {
+			defer wg.Done()
+
+			sem <- struct{}{}
+			p, err := c.Get(ctx, id)
+			<-sem
+
+			mu.Lock()
+			defer mu.Unlock()
+			switch {
+			case errors.Is(err, ErrNotFound):
+			case err != nil:
+				errs = append(errs, err)
+			default:
+				res[id] = p
+			}
+		}(id)
+	}
+
+	wg.Wait()
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return res, nil
+}
+
+// UserExists reports whether profiles-svc knows the user.
+func (c *Client) UserExists(ctx context.Context, userID uuid.UUID) (bool, error) {
+	_, err := c.Get(ctx, userID)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+type profileResponse struct {
+	Data struct {
+		ID         uuid.UUID `json:"id"`
+		Attributes struct {
+			Username string  `json:"username"`
+			Avatar   *string `json:"avatar,omitempty"`
+		} `json:"attributes"`
+	} `json:"data"`
+}
+
+// retryableError marks failures that may succeed on the next attempt.
+type retryableError struct {
+	err error
+}
+
+func (e retryableError) Error() string { return e.err.Error() }
+
+func (e retryableError) Unwrap() error { return e.err }
+
+func (c *Client) fetch(ctx context.Context, userID uuid.UUID) (Profile, error) {
+	ctx, span := tracer.Start(ctx, "profiles.Get", trace.WithSpanKind(trace.SpanKindClient))
+	defer span.End()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.url+"/"+userID.String(), nil)
+	if err != nil {
+		return Profile{}, fmt.Errorf("build profile request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		if ctx.Err() != nil {
+			return Profile{}, fmt.Errorf("request profile %s: %w", userID, err)
+		}
+		return Profile{}, retryableError{fmt.Errorf("request profile %s: %w", userID, err)}
+	}
+	defer resp.Body.Close()
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return Profile{}, ErrNotFound
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError:
+		span.SetStatus(codes.Error, resp.Status)
+		return Profile{}, retryableError{fmt.Errorf("profiles-svc responded with %s", resp.Status)}
+	case resp.StatusCode != http.StatusOK:
+		span.SetStatus(codes.Error, resp.Status)
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return Profile{}, fmt.Errorf("profiles-svc responded with %s: %s", resp.Status, body)
+	}
+
+	var res profileResponse
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return Profile{}, fmt.Errorf("decode profile %s: %w", userID, err)
+	}
+
+	return Profile{
+		UserID:   userID,
+		Username: res.Data.Attributes.Username,
+		Avatar:   res.Data.Attributes.Avatar,
+	}, nil
+}
+
+func backoff(attempt int) time.Duration {
+	return time.Duration(1<<(attempt-1)) * 100 * time.Millisecond
+}
@@ -0,0 +1,84 @@
+package profiles_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/chains-lab/cities-svc/internal/profiles/profilestest"
+	"github.com/google/uuid"
+)
+
+func TestClientGet(t *testing.T) {
+	ctx := context.Background()
+	user := profiles.Profile{UserID: uuid.New(), Username: "alice"}
+
+	srv := profilestest.NewServer(user)
+	defer srv.Close()
+
+	c := profiles.New(srv.ProfilesURL(), time.Second, 2, time.Minute)
+
+	p, err := c.Get(ctx, user.UserID)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if p.Username != "alice" {
+		t.Fatalf("unexpected username %q", p.Username)
+	}
+
+	if _, err = c.Get(ctx, user.UserID); err != nil {
+		t.Fatalf("cached get: %v", err)
+	}
+	if n := srv.Requests(); n != 1 {
+		t.Fatalf("expected cached profile, got %d requests", n)
+	}
+
+	if _, err = c.Get(ctx, uuid.New()); !errors.Is(err, profiles.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestClientRetries(t *testing.T) {
+	ctx := context.Background()
+	user := profiles.Profile{UserID: uuid.New(), Username: "bob"}
+
+	srv := profilestest.NewServer(user)
+	defer srv.Close()
+
+	srv.FailNext(2)
+	c := profiles.New(srv.ProfilesURL(), time.Second, 2, 0)
+	if _, err := c.Get(ctx, user.UserID); err != nil {
+		t.Fatalf("get after retries: %v", err)
+	}
+
+	srv.FailNext(3)
+	if _, err := c.Get(ctx, user.UserID); err == nil {
+		t.Fatal("expected error when retries are exhausted")
+	}
+}
+
+func TestClientGetMany(t *testing.T) {
+	ctx := context.Background()
+	alice := profiles.Profile{UserID: uuid.New(), Username: "alice"}
+	bob := profiles.Profile{UserID: uuid.New(), Username: "bob"}
+
+	srv := profilestest.NewServer(alice, bob)
+	defer srv.Close()
+
+	c := profiles.New(srv.ProfilesURL(), time.Second, 0, time.Minute)
+
+	res, err := c.GetMany(ctx, alice.UserID, bob.UserID, alice.UserID, uuid.New())
+	if err != nil {
+		t.Fatalf("get many: %v", err)
+	}
+	if len(res) != 2 || res[alice.UserID].Username != "alice" || res[bob.UserID].Username != "bob" {
+		t.Fatalf("unexpected profiles: %v", res)
+	}
+
+	ok, err := c.UserExists(ctx, uuid.New())
+	if err != nil || ok {
+		t.Fatalf("expected unknown user, got %v %v", ok, err)
+	}
+}
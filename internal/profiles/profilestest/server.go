@@ -0,0 +1,113 @@
+// Package profilestest provides an in-process profiles-svc stub for tests and local runs.
+package profilestest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/google/uuid"
+)
+
+const Path = "/profiles-svc/v1/profiles"
+
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	profiles map[uuid.UUID]profiles.Profile
+	failures int
+	requests int
+}
+
+// NewServer starts a stub serving GET Path/{user_id} for the given profiles.
+func NewServer(ps ...profiles.Profile) *Server {
+	s := &Server{
+		profiles: make(map[uuid.UUID]profiles.Profile, len(ps)),
+	}
+	for _, p := range ps {
+		s.profiles[p.UserID] = p
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serve))
+
+	return s
+}
+
+// ProfilesURL is the base url to configure the client with.
+func (s *Server) ProfilesURL() string {
+	return s.URL + Path
+}
+
+func (s *Server) Add(p profiles.Profile) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.profiles[p.UserID] = p
+}
+
+// FailNext makes the next n requests fail with 503.
+func (s *Server) FailNext(n int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.failures = n
+}
+
+// Requests returns the number of requests served so far.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.requests
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests++
+	fail := s.failures > 0
+	if fail {
+		s.failures--
+	}
+	s.mu.Unlock()
+
+	if fail {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	raw, ok := strings.CutPrefix(r.URL.Path, Path+"/")
+	if r.Method != http.MethodGet || !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	userID, err := uuid.Parse(raw)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	s.mu.Lock()
+	p, ok := s.profiles[userID]
+	s.mu.Unlock()
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{
+		"data": map[string]any{
+			"id":   p.UserID,
+			"type": "profile",
+			"attributes": map[string]any{
+				"username": p.Username,
+				"avatar":   p.Avatar,
+			},
+		},
+	})
+}
@@ -26,7 +26,7 @@ func (r *Repo) CreateCity(ctx context.Context, m models.City) (models.City, erro
 }
 
 func (r *Repo) GetCityByID(ctx context.Context, id uuid.UUID) (models.City, error) {
-	row, err := r.sql.cities.New().FilterID(id).Get(ctx)
+	row, err := r.read(ctx).cities.New().FilterID(id).Get(ctx)
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return models.City{}, nil
@@ -38,7 +38,7 @@ func (r *Repo) GetCityByID(ctx context.Context, id uuid.UUID) (models.City, erro
 }
 
 func (r *Repo) GetCityBySlug(ctx context.Context, slug string) (models.City, error) {
-	row, err := r.sql.cities.New().FilterSlug(slug).Get(ctx)
+	row, err := r.read(ctx).cities.New().FilterSlug(slug).Get(ctx)
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return models.City{}, nil
@@ -50,7 +50,10 @@ func (r *Repo) GetCityBySlug(ctx context.Context, slug string) (models.City, err
 }
 
 func (r *Repo) GetCityByRadius(ctx context.Context, point orb.Point, radius uint64) (models.City, error) {
-	row, err := r.sql.cities.New().FilterWithinRadiusMeters(point, radius).Get(ctx)
+	row, err := r.read(ctx).cities.New().
+		FilterWithinRadiusMeters(point, radius).
+		OrderByNearest(point, true).
+		Get(ctx)
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return models.City{}, nil
@@ -61,6 +64,20 @@ func (r *Repo) GetCityByRadius(ctx context.Context, point orb.Point, radius uint
 	return citySchemaToModel(row), nil
 }
 
+func (r *Repo) GetCitiesByIDs(ctx context.Context, ids []uuid.UUID) ([]models.City, error) {
+	rows, err := r.read(ctx).cities.New().FilterID(ids...).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	cities := make([]models.City, 0, len(rows))
+	for _, r := range rows {
+		cities = append(cities, citySchemaToModel(r))
+	}
+
+	return cities, nil
+}
+
 func (r *Repo) FilterCities(
 	ctx context.Context,
 	filter city.FilterParams,
@@ -68,19 +85,22 @@ func (r *Repo) FilterCities(
 ) (models.CitiesCollection, error) {
 	limit, offset := pagi.PagConvert(page, size)
 
-	query := r.sql.cities.New()
+	query := r.read(ctx).cities.New()
 
+	if len(filter.ID) > 0 {
+		query = query.FilterID(filter.ID...)
+	}
 	if filter.CountryID != nil {
-		query.FilterCountryID(*filter.CountryID)
+		query = query.FilterCountryID(*filter.CountryID)
 	}
 	if filter.Name != nil {
-		query.FilterNameLike(*filter.Name)
+		query = query.FilterNameLike(*filter.Name)
 	}
 	if filter.Status != nil {
-		query.FilterStatus(*filter.Status)
+		query = query.FilterStatus(*filter.Status)
 	}
 	if filter.Location != nil {
-		query.FilterWithinRadiusMeters(filter.Location.Point, filter.Location.RadiusM)
+		query = query.FilterWithinRadiusMeters(filter.Location.Point, filter.Location.RadiusM)
 	}
 
 	rows, err := query.Page(limit, offset).Select(ctx)
@@ -18,6 +18,8 @@ func (r *Repo) CreateCityAdmin(ctx context.Context, cityMod models.CityAdmin) er
 	return r.sql.cityAdmin.New().Insert(ctx, CityAdminModelToSchema(cityMod))
 }
 
+// GetCityAdmin reads the primary, it authorizes city admins and a lagging
+// replica would let a just removed admin act.
 func (r *Repo) GetCityAdmin(ctx context.Context, userID, cityID uuid.UUID) (models.CityAdmin, error) {
 	row, err := r.sql.cityAdmin.New().FilterCityID(cityID).FilterUserID(userID).Get(ctx)
 	switch {
@@ -31,7 +33,7 @@ func (r *Repo) GetCityAdmin(ctx context.Context, userID, cityID uuid.UUID) (mode
 }
 
 func (r *Repo) GetCityTechLead(ctx context.Context, cityID uuid.UUID) (models.CityAdmin, error) {
-	row, err := r.sql.cityAdmin.New().FilterCityID(cityID).FilterRole(enum.CityAdminRoleTechLead).Get(ctx)
+	row, err := r.read(ctx).cityAdmin.New().FilterCityID(cityID).FilterRole(enum.CityAdminRoleTechLead).Get(ctx)
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
 		return models.CityAdmin{}, nil
@@ -43,7 +45,7 @@ func (r *Repo) GetCityTechLead(ctx context.Context, cityID uuid.UUID) (models.Ci
 }
 
 func (r *Repo) GetCityAdmins(ctx context.Context, cityID uuid.UUID, roles ...string) (models.CityAdminsCollection, error) {
-	query := r.sql.cityAdmin.New().FilterCityID(cityID)
+	query := r.read(ctx).cityAdmin.New().FilterCityID(cityID)
 	if len(roles) > 0 {
 		query = query.FilterRole(roles...)
 	}
@@ -66,6 +68,53 @@ func (r *Repo) GetCityAdmins(ctx context.Context, cityID uuid.UUID, roles ...str
 	}, nil
 }
 
+func (r *Repo) GetUserCityAdmins(ctx context.Context, userID uuid.UUID) ([]models.CityAdmin, error) {
+	rows, err := r.read(ctx).cityAdmin.New().FilterUserID(userID).OrderByCreatedAt(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.CityAdmin, len(rows))
+	for i, row := range rows {
+		res[i] = CityAdminSchemaToModel(row)
+	}
+
+	return res, nil
+}
+
+func (r *Repo) GetAdminsForCities(ctx context.Context, cityIDs []uuid.UUID) ([]models.CityAdmin, error) {
+	rows, err := r.read(ctx).cityAdmin.New().FilterCityID(cityIDs...).OrderByCreatedAt(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.CityAdmin, len(rows))
+	for i, row := range rows {
+		res[i] = CityAdminSchemaToModel(row)
+	}
+
+	return res, nil
+}
+
+func (r *Repo) GetCityAdminsByKeys(ctx context.Context, keys []admin.Key) ([]models.CityAdmin, error) {
+	pairs := make([][2]uuid.UUID, len(keys))
+	for i, k := range keys {
+		pairs[i] = [2]uuid.UUID{k.UserID, k.CityID}
+	}
+
+	rows, err := r.read(ctx).cityAdmin.New().FilterUserCityPairs(pairs...).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.CityAdmin, len(rows))
+	for i, r := range rows {
+		res[i] = CityAdminSchemaToModel(r)
+	}
+
+	return res, nil
+}
+
 func (r *Repo) FilterCityAdmins(
 	ctx context.Context,
 	filter admin.FilterParams,
@@ -73,16 +122,16 @@ func (r *Repo) FilterCityAdmins(
 ) (models.CityAdminsCollection, error) {
 	limit, offset := pagi.PagConvert(page, size)
 
-	query := r.sql.cityAdmin.New()
+	query := r.read(ctx).cityAdmin.New()
 
 	if filter.UserID != nil {
-		query.FilterUserID(filter.UserID...)
+		query = query.FilterUserID(filter.UserID...)
 	}
 	if filter.CityID != nil {
-		query.FilterCityID(filter.CityID...)
+		query = query.FilterCityID(filter.CityID...)
 	}
 	if filter.Roles != nil {
-		query.FilterRole(filter.Roles...)
+		query = query.FilterRole(filter.Roles...)
 	}
 
 	total, err := query.Count(ctx)
@@ -117,22 +166,22 @@ func (r *Repo) UpdateCityAdmin(
 	q := r.sql.cityAdmin.New().FilterUserID(userID).FilterCityID(cityID)
 
 	if params.Role != nil {
-		q.UpdateRole(*params.Role)
+		q = q.UpdateRole(*params.Role)
 	}
 	if params.Label != nil {
 		switch *params.Label {
 		case "":
-			q.UpdateLabel(sql.NullString{Valid: false})
+			q = q.UpdateLabel(sql.NullString{Valid: false})
 		default:
-			q.UpdateLabel(sql.NullString{String: *params.Label, Valid: true})
+			q = q.UpdateLabel(sql.NullString{String: *params.Label, Valid: true})
 		}
 	}
 	if params.Position != nil {
 		switch *params.Position {
 		case "":
-			q.UpdatePosition(sql.NullString{Valid: false})
+			q = q.UpdatePosition(sql.NullString{Valid: false})
 		default:
-			q.UpdatePosition(sql.NullString{String: *params.Position, Valid: true})
+			q = q.UpdatePosition(sql.NullString{String: *params.Position, Valid: true})
 		}
 	}
 
@@ -0,0 +1,74 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/google/uuid"
+)
+
+func (r *Repo) CreateIdempotencyKey(ctx context.Context, input models.IdempotencyKey) (bool, error) {
+	return r.sql.idempotencyKeys.New().Insert(ctx, pgdb.IdempotencyKey{
+		UserID:      input.UserID,
+		Key:         input.Key,
+		Fingerprint: input.Fingerprint,
+		LockedUntil: input.LockedUntil,
+		ExpiresAt:   input.ExpiresAt,
+		CreatedAt:   input.CreatedAt,
+	})
+}
+
+// GetIdempotencyKey always reads the primary, a lagging replica would take a
+// key which was just claimed for unused.
+func (r *Repo) GetIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) (models.IdempotencyKey, error) {
+	row, err := r.sql.idempotencyKeys.New().FilterUserID(userID).FilterKey(key).Get(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return models.IdempotencyKey{}, nil
+	case err != nil:
+		return models.IdempotencyKey{}, err
+	}
+
+	return idempotencyKeySchemaToModel(row), nil
+}
+
+func (r *Repo) UpdateIdempotencyKeyResponse(
+	ctx context.Context,
+	userID uuid.UUID,
+	key string,
+	statusCode int,
+	contentType string,
+	body []byte,
+) error {
+	return r.sql.idempotencyKeys.New().
+		FilterUserID(userID).
+		FilterKey(key).
+		UpdateResponse(statusCode, contentType, body).
+		Update(ctx)
+}
+
+func (r *Repo) DeleteIdempotencyKey(ctx context.Context, userID uuid.UUID, key string) error {
+	return r.sql.idempotencyKeys.New().FilterUserID(userID).FilterKey(key).Delete(ctx)
+}
+
+func (r *Repo) DeleteExpiredIdempotencyKeys(ctx context.Context, now time.Time) error {
+	return r.sql.idempotencyKeys.New().FilterExpiredAt(now).Delete(ctx)
+}
+
+func idempotencyKeySchemaToModel(s pgdb.IdempotencyKey) models.IdempotencyKey {
+	return models.IdempotencyKey{
+		UserID:      s.UserID,
+		Key:         s.Key,
+		Fingerprint: s.Fingerprint,
+		StatusCode:  int(s.StatusCode.Int32),
+		ContentType: s.ContentType.String,
+		Body:        s.ResponseBody,
+		LockedUntil: s.LockedUntil,
+		ExpiresAt:   s.ExpiresAt,
+		CreatedAt:   s.CreatedAt,
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
 	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/restkit/pagi"
 	"github.com/google/uuid"
 )
 
@@ -16,11 +19,12 @@ func (r *Repo) CreateInvite(ctx context.Context, input models.Invite) error {
 	return r.sql.invites.New().Insert(ctx, schema)
 }
 
+// GetInvite reads the primary, answers and revokes check the invite status.
 func (r *Repo) GetInvite(ctx context.Context, ID uuid.UUID) (models.Invite, error) {
 	row, err := r.sql.invites.New().FilterID(ID).Get(ctx)
 	switch {
 	case errors.Is(err, sql.ErrNoRows):
-		return models.Invite{}, err
+		return models.Invite{}, nil
 	case err != nil:
 		return models.Invite{}, err
 	}
@@ -28,6 +32,72 @@ func (r *Repo) GetInvite(ctx context.Context, ID uuid.UUID) (models.Invite, erro
 	return inviteSchemaToModel(row), nil
 }
 
+func (r *Repo) GetUserInvites(
+	ctx context.Context,
+	userID uuid.UUID,
+	status string,
+	expiresAfter time.Time,
+) ([]models.Invite, error) {
+	rows, err := r.read(ctx).invites.New().
+		FilterUserID(userID).
+		FilterStatus(status).
+		FilterExpiresAfter(expiresAfter).
+		OrderByCreatedAt(false).
+		Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.Invite, len(rows))
+	for i, row := range rows {
+		res[i] = inviteSchemaToModel(row)
+	}
+
+	return res, nil
+}
+
+func (r *Repo) FilterInvites(
+	ctx context.Context,
+	filter invite.FilterParams,
+	page, size uint64,
+) (models.InvitesCollection, error) {
+	limit, offset := pagi.PagConvert(page, size)
+
+	query := r.read(ctx).invites.New()
+
+	if filter.CityID != nil {
+		query = query.FilterCityID(filter.CityID...)
+	}
+	if filter.UserID != nil {
+		query = query.FilterUserID(filter.UserID...)
+	}
+	if filter.Status != nil {
+		query = query.FilterStatus(filter.Status...)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return models.InvitesCollection{}, err
+	}
+
+	rows, err := query.OrderByCreatedAt(false).Page(limit, offset).Select(ctx)
+	if err != nil {
+		return models.InvitesCollection{}, err
+	}
+
+	res := make([]models.Invite, len(rows))
+	for i, row := range rows {
+		res[i] = inviteSchemaToModel(row)
+	}
+
+	return models.InvitesCollection{
+		Data:  res,
+		Page:  page,
+		Size:  size,
+		Total: total,
+	}, nil
+}
+
 func (r *Repo) UpdateInviteStatus(ctx context.Context, inviteID uuid.UUID, status string) error {
 	err := r.sql.invites.New().
 		FilterID(inviteID).
@@ -36,15 +106,31 @@ func (r *Repo) UpdateInviteStatus(ctx context.Context, inviteID uuid.UUID, statu
 	return err
 }
 
+// UpdateInviteStatusFrom moves the invite to status to only if it is still in
+// status from, it reports whether the invite was updated.
+func (r *Repo) UpdateInviteStatusFrom(ctx context.Context, inviteID uuid.UUID, from, to string) (bool, error) {
+	affected, err := r.sql.invites.New().
+		FilterID(inviteID).
+		FilterStatus(from).
+		UpdateStatus(to).
+		UpdateAffected(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
 func inviteSchemaToModel(s pgdb.Invite) models.Invite {
 	res := models.Invite{
-		ID:        s.ID,
-		Status:    s.Status,
-		Role:      s.Role,
-		CityID:    s.CityID,
-		UserID:    s.UserID,
-		CreatedAt: s.CreatedAt,
-		ExpiresAt: s.ExpiresAt,
+		ID:          s.ID,
+		Status:      s.Status,
+		Role:        s.Role,
+		CityID:      s.CityID,
+		UserID:      s.UserID,
+		InitiatorID: s.InitiatorID,
+		CreatedAt:   s.CreatedAt,
+		ExpiresAt:   s.ExpiresAt,
 	}
 
 	return res
@@ -52,13 +138,14 @@ func inviteSchemaToModel(s pgdb.Invite) models.Invite {
 
 func modelToInviteSchema(m models.Invite) pgdb.Invite {
 	res := pgdb.Invite{
-		ID:        m.ID,
-		Status:    m.Status,
-		Role:      m.Role,
-		CityID:    m.CityID,
-		UserID:    m.UserID,
-		ExpiresAt: m.ExpiresAt,
-		CreatedAt: m.CreatedAt,
+		ID:          m.ID,
+		Status:      m.Status,
+		Role:        m.Role,
+		CityID:      m.CityID,
+		UserID:      m.UserID,
+		InitiatorID: m.InitiatorID,
+		ExpiresAt:   m.ExpiresAt,
+		CreatedAt:   m.CreatedAt,
 	}
 
 	return res
@@ -0,0 +1,223 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
+
+func (db *DB) CreateCity(_ context.Context, m models.City) (models.City, error) {
+	err := db.write(func(s state) error {
+		if _, ok := s.cities[m.ID]; ok {
+			return fmt.Errorf("%w: city %s already exists", ErrUniqueViolation, m.ID)
+		}
+		if err := checkSlugFree(s, m.ID, m.Slug); err != nil {
+			return err
+		}
+
+		s.cities[m.ID] = m
+		return nil
+	})
+	if err != nil {
+		return models.City{}, err
+	}
+
+	return m, nil
+}
+
+func (db *DB) GetCityByID(_ context.Context, id uuid.UUID) (res models.City, _ error) {
+	db.read(func(s state) {
+		res = s.cities[id]
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetCityBySlug(_ context.Context, slug string) (res models.City, _ error) {
+	db.read(func(s state) {
+		for _, c := range s.cities {
+			if c.Slug != nil && *c.Slug == slug {
+				res = c
+				return
+			}
+		}
+	})
+
+	return res, nil
+}
+
+// GetCityByRadius returns the nearest city within radius meters of point.
+func (db *DB) GetCityByRadius(_ context.Context, point orb.Point, radius uint64) (res models.City, _ error) {
+	nearest := math.Inf(1)
+	db.read(func(s state) {
+		for _, c := range s.cities {
+			d := geo.Distance(point, c.Point)
+			if d <= float64(radius) && d < nearest {
+				res, nearest = c, d
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetCitiesByIDs(_ context.Context, ids []uuid.UUID) ([]models.City, error) {
+	res := make([]models.City, 0, len(ids))
+	db.read(func(s state) {
+		for _, id := range ids {
+			if c, ok := s.cities[id]; ok {
+				res = append(res, c)
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func (db *DB) FilterCities(
+	_ context.Context,
+	filter city.FilterParams,
+	pageNum, size uint64,
+) (models.CitiesCollection, error) {
+	var res []models.City
+	db.read(func(s state) {
+		for _, c := range s.cities {
+			if len(filter.ID) > 0 && !slices.Contains(filter.ID, c.ID) {
+				continue
+			}
+			if filter.CountryID != nil && c.CountryID != *filter.CountryID {
+				continue
+			}
+			if filter.Name != nil && !strings.Contains(strings.ToLower(c.Name), strings.ToLower(*filter.Name)) {
+				continue
+			}
+			if filter.Status != nil && c.Status != *filter.Status {
+				continue
+			}
+			if filter.Location != nil && geo.Distance(filter.Location.Point, c.Point) > float64(filter.Location.RadiusM) {
+				continue
+			}
+			res = append(res, c)
+		}
+	})
+
+	sortByCreatedAt(res,
+		func(c models.City) time.Time { return c.CreatedAt },
+		func(c models.City) string { return c.ID.String() },
+		true,
+	)
+
+	return models.CitiesCollection{
+		Data:  page(res, pageNum, size),
+		Page:  pageNum,
+		Size:  size,
+		Total: uint64(len(res)),
+	}, nil
+}
+
+func (db *DB) UpdateCity(
+	_ context.Context,
+	cityID uuid.UUID,
+	params city.UpdateParams,
+	updatedAt time.Time,
+) error {
+	if params == (city.UpdateParams{}) {
+		return nil
+	}
+
+	return db.write(func(s state) error {
+		c, ok := s.cities[cityID]
+		if !ok {
+			return nil
+		}
+
+		if params.Name != nil {
+			c.Name = *params.Name
+		}
+		if params.Slug != nil {
+			if err := checkSlugFree(s, cityID, params.Slug); err != nil {
+				return err
+			}
+			c.Slug = ptr(*params.Slug)
+		}
+		if params.Icon != nil {
+			c.Icon = ptr(*params.Icon)
+		}
+		if params.Timezone != nil {
+			c.Timezone = *params.Timezone
+		}
+		if params.Point != nil {
+			c.Point = *params.Point
+		}
+		c.UpdatedAt = updatedAt
+
+		s.cities[cityID] = c
+		return nil
+	})
+}
+
+// UpdateCityStatus mirrors the check_city_status_change trigger, a city with
+// admins cannot become unsupported.
+func (db *DB) UpdateCityStatus(_ context.Context, id uuid.UUID, status string, updatedAt time.Time) error {
+	return db.write(func(s state) error {
+		c, ok := s.cities[id]
+		if !ok {
+			return nil
+		}
+
+		if status == enum.CityStatusUnsupported {
+			for k := range s.admins {
+				if k.cityID == id {
+					return fmt.Errorf("%w: city %s has admins, cannot set status %s", ErrCheckViolation, id, status)
+				}
+			}
+		}
+
+		c.Status = status
+		c.UpdatedAt = updatedAt
+		s.cities[id] = c
+		return nil
+	})
+}
+
+func checkSlugFree(s state, cityID uuid.UUID, slug *string) error {
+	if slug == nil {
+		return nil
+	}
+
+	for _, c := range s.cities {
+		if c.ID != cityID && c.Slug != nil && *c.Slug == *slug {
+			return fmt.Errorf("%w: slug %s is taken by city %s", ErrUniqueViolation, *slug, c.ID)
+		}
+	}
+
+	return nil
+}
+
+// checkCityAllowsAdmins mirrors the triggers which forbid admins and invites
+// in cities which are suspended or unsupported.
+func checkCityAllowsAdmins(s state, cityID uuid.UUID) error {
+	c, ok := s.cities[cityID]
+	if !ok {
+		return fmt.Errorf("%w: city %s does not exist", ErrForeignKeyViolation, cityID)
+	}
+	if c.Status == enum.CityStatusSuspended || c.Status == enum.CityStatusUnsupported {
+		return fmt.Errorf("%w: city %s has status %s", ErrCheckViolation, cityID, c.Status)
+	}
+
+	return nil
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -0,0 +1,202 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/google/uuid"
+)
+
+func (db *DB) CreateCityAdmin(_ context.Context, m models.CityAdmin) error {
+	return db.write(func(s state) error {
+		key := adminKey{userID: m.UserID, cityID: m.CityID}
+		if _, ok := s.admins[key]; ok {
+			return fmt.Errorf("%w: user %s is already admin of city %s", ErrUniqueViolation, m.UserID, m.CityID)
+		}
+		if err := checkCityAllowsAdmins(s, m.CityID); err != nil {
+			return err
+		}
+		if err := checkUniqueRole(s, key, m.Role); err != nil {
+			return err
+		}
+
+		s.admins[key] = m
+		return nil
+	})
+}
+
+func (db *DB) GetCityAdmin(_ context.Context, userID, cityID uuid.UUID) (res models.CityAdmin, _ error) {
+	db.read(func(s state) {
+		res = s.admins[adminKey{userID: userID, cityID: cityID}]
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetCityTechLead(_ context.Context, cityID uuid.UUID) (res models.CityAdmin, _ error) {
+	db.read(func(s state) {
+		for k, a := range s.admins {
+			if k.cityID == cityID && a.Role == enum.CityAdminRoleTechLead {
+				res = a
+				return
+			}
+		}
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetCityAdmins(_ context.Context, cityID uuid.UUID, roles ...string) (models.CityAdminsCollection, error) {
+	res := db.selectAdmins(func(a models.CityAdmin) bool {
+		return a.CityID == cityID && (len(roles) == 0 || slices.Contains(roles, a.Role))
+	})
+
+	return models.CityAdminsCollection{
+		Data:  res,
+		Page:  1,
+		Size:  uint64(len(res)),
+		Total: uint64(len(res)),
+	}, nil
+}
+
+func (db *DB) GetUserCityAdmins(_ context.Context, userID uuid.UUID) ([]models.CityAdmin, error) {
+	return db.selectAdmins(func(a models.CityAdmin) bool {
+		return a.UserID == userID
+	}), nil
+}
+
+func (db *DB) GetAdminsForCities(_ context.Context, cityIDs []uuid.UUID) ([]models.CityAdmin, error) {
+	return db.selectAdmins(func(a models.CityAdmin) bool {
+		return slices.Contains(cityIDs, a.CityID)
+	}), nil
+}
+
+func (db *DB) GetCityAdminsByKeys(_ context.Context, keys []admin.Key) ([]models.CityAdmin, error) {
+	return db.selectAdmins(func(a models.CityAdmin) bool {
+		return slices.Contains(keys, admin.Key{UserID: a.UserID, CityID: a.CityID})
+	}), nil
+}
+
+func (db *DB) FilterCityAdmins(
+	_ context.Context,
+	filter admin.FilterParams,
+	pageNum, size uint64,
+) (models.CityAdminsCollection, error) {
+	res := db.selectAdmins(func(a models.CityAdmin) bool {
+		if filter.UserID != nil && !slices.Contains(filter.UserID, a.UserID) {
+			return false
+		}
+		if filter.CityID != nil && !slices.Contains(filter.CityID, a.CityID) {
+			return false
+		}
+		if filter.Roles != nil && !slices.Contains(filter.Roles, a.Role) {
+			return false
+		}
+		return true
+	})
+
+	return models.CityAdminsCollection{
+		Data:  page(res, pageNum, size),
+		Page:  pageNum,
+		Size:  size,
+		Total: uint64(len(res)),
+	}, nil
+}
+
+func (db *DB) UpdateCityAdmin(
+	_ context.Context,
+	userID, cityID uuid.UUID,
+	params admin.UpdateParams,
+	updatedAt time.Time,
+) error {
+	return db.write(func(s state) error {
+		key := adminKey{userID: userID, cityID: cityID}
+		a, ok := s.admins[key]
+		if !ok {
+			return nil
+		}
+
+		if params.Role != nil {
+			if err := checkUniqueRole(s, key, *params.Role); err != nil {
+				return err
+			}
+			a.Role = *params.Role
+		}
+		if params.Label != nil {
+			a.Label = nullable(*params.Label)
+		}
+		if params.Position != nil {
+			a.Position = nullable(*params.Position)
+		}
+		a.UpdatedAt = updatedAt
+
+		s.admins[key] = a
+		return nil
+	})
+}
+
+func (db *DB) DeleteCityAdmin(_ context.Context, userID, cityID uuid.UUID) error {
+	return db.write(func(s state) error {
+		delete(s.admins, adminKey{userID: userID, cityID: cityID})
+		return nil
+	})
+}
+
+func (db *DB) DeleteAdminsForCity(_ context.Context, cityID uuid.UUID) error {
+	return db.write(func(s state) error {
+		for k := range s.admins {
+			if k.cityID == cityID {
+				delete(s.admins, k)
+			}
+		}
+		return nil
+	})
+}
+
+func (db *DB) selectAdmins(match func(a models.CityAdmin) bool) []models.CityAdmin {
+	res := make([]models.CityAdmin, 0)
+	db.read(func(s state) {
+		for _, a := range s.admins {
+			if match(a) {
+				res = append(res, a)
+			}
+		}
+	})
+
+	sortByCreatedAt(res,
+		func(a models.CityAdmin) time.Time { return a.CreatedAt },
+		func(a models.CityAdmin) string { return a.UserID.String() + a.CityID.String() },
+		true,
+	)
+
+	return res
+}
+
+// checkUniqueRole mirrors the partial unique indexes allowing one chief and
+// one tech lead per city.
+func checkUniqueRole(s state, key adminKey, role string) error {
+	if role != enum.CityAdminRoleChief && role != enum.CityAdminRoleTechLead {
+		return nil
+	}
+
+	for k, a := range s.admins {
+		if k != key && k.cityID == key.cityID && a.Role == role {
+			return fmt.Errorf("%w: city %s already has %s %s", ErrUniqueViolation, key.cityID, role, a.UserID)
+		}
+	}
+
+	return nil
+}
+
+func nullable(v string) *string {
+	if v == "" {
+		return nil
+	}
+
+	return &v
+}
@@ -0,0 +1,74 @@
+package memory
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/google/uuid"
+)
+
+func (db *DB) CreateIdempotencyKey(_ context.Context, m models.IdempotencyKey) (created bool, _ error) {
+	err := db.write(func(s state) error {
+		k := idempotencyKey{userID: m.UserID, key: m.Key}
+		if _, ok := s.idempotencyKeys[k]; ok {
+			return nil
+		}
+
+		s.idempotencyKeys[k] = m
+		created = true
+		return nil
+	})
+
+	return created, err
+}
+
+func (db *DB) GetIdempotencyKey(_ context.Context, userID uuid.UUID, key string) (res models.IdempotencyKey, _ error) {
+	db.read(func(s state) {
+		res = s.idempotencyKeys[idempotencyKey{userID: userID, key: key}]
+	})
+
+	return res, nil
+}
+
+func (db *DB) UpdateIdempotencyKeyResponse(
+	_ context.Context,
+	userID uuid.UUID,
+	key string,
+	statusCode int,
+	contentType string,
+	body []byte,
+) error {
+	return db.write(func(s state) error {
+		k := idempotencyKey{userID: userID, key: key}
+		m, ok := s.idempotencyKeys[k]
+		if !ok {
+			return nil
+		}
+
+		m.StatusCode = statusCode
+		m.ContentType = contentType
+		m.Body = slices.Clone(body)
+		s.idempotencyKeys[k] = m
+		return nil
+	})
+}
+
+func (db *DB) DeleteIdempotencyKey(_ context.Context, userID uuid.UUID, key string) error {
+	return db.write(func(s state) error {
+		delete(s.idempotencyKeys, idempotencyKey{userID: userID, key: key})
+		return nil
+	})
+}
+
+func (db *DB) DeleteExpiredIdempotencyKeys(_ context.Context, now time.Time) error {
+	return db.write(func(s state) error {
+		for k, m := range s.idempotencyKeys {
+			if !m.ExpiresAt.After(now) {
+				delete(s.idempotencyKeys, k)
+			}
+		}
+		return nil
+	})
+}
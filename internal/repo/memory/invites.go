@@ -0,0 +1,120 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/google/uuid"
+)
+
+func (db *DB) CreateInvite(_ context.Context, m models.Invite) error {
+	return db.write(func(s state) error {
+		if _, ok := s.invites[m.ID]; ok {
+			return fmt.Errorf("%w: invite %s already exists", ErrUniqueViolation, m.ID)
+		}
+		if err := checkCityAllowsAdmins(s, m.CityID); err != nil {
+			return err
+		}
+
+		s.invites[m.ID] = m
+		return nil
+	})
+}
+
+func (db *DB) GetInvite(_ context.Context, id uuid.UUID) (res models.Invite, _ error) {
+	db.read(func(s state) {
+		res = s.invites[id]
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetUserInvites(
+	_ context.Context,
+	userID uuid.UUID,
+	status string,
+	expiresAfter time.Time,
+) ([]models.Invite, error) {
+	return db.selectInvites(func(i models.Invite) bool {
+		return i.UserID == userID && i.Status == status && i.ExpiresAt.After(expiresAfter)
+	}), nil
+}
+
+func (db *DB) FilterInvites(
+	_ context.Context,
+	filter invite.FilterParams,
+	pageNum, size uint64,
+) (models.InvitesCollection, error) {
+	res := db.selectInvites(func(i models.Invite) bool {
+		if filter.CityID != nil && !slices.Contains(filter.CityID, i.CityID) {
+			return false
+		}
+		if filter.UserID != nil && !slices.Contains(filter.UserID, i.UserID) {
+			return false
+		}
+		if filter.Status != nil && !slices.Contains(filter.Status, i.Status) {
+			return false
+		}
+		return true
+	})
+
+	return models.InvitesCollection{
+		Data:  page(res, pageNum, size),
+		Page:  pageNum,
+		Size:  size,
+		Total: uint64(len(res)),
+	}, nil
+}
+
+func (db *DB) UpdateInviteStatus(_ context.Context, inviteID uuid.UUID, status string) error {
+	return db.write(func(s state) error {
+		i, ok := s.invites[inviteID]
+		if !ok {
+			return nil
+		}
+
+		i.Status = status
+		s.invites[inviteID] = i
+		return nil
+	})
+}
+
+func (db *DB) UpdateInviteStatusFrom(_ context.Context, inviteID uuid.UUID, from, to string) (updated bool, _ error) {
+	err := db.write(func(s state) error {
+		i, ok := s.invites[inviteID]
+		if !ok || i.Status != from {
+			return nil
+		}
+
+		i.Status = to
+		s.invites[inviteID] = i
+		updated = true
+		return nil
+	})
+
+	return updated, err
+}
+
+// selectInvites returns the matching invites, newest first.
+func (db *DB) selectInvites(match func(i models.Invite) bool) []models.Invite {
+	res := make([]models.Invite, 0)
+	db.read(func(s state) {
+		for _, i := range s.invites {
+			if match(i) {
+				res = append(res, i)
+			}
+		}
+	})
+
+	sortByCreatedAt(res,
+		func(i models.Invite) time.Time { return i.CreatedAt },
+		func(i models.Invite) string { return i.ID.String() },
+		false,
+	)
+
+	return res
+}
@@ -0,0 +1,141 @@
+// Package memory is an in-memory implementation of the database interfaces of
+// the domain services. It mirrors the constraints and triggers of the
+// Postgres schema so that domain tests run without a database.
+package memory
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/restkit/pagi"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrUniqueViolation     = errors.New("unique violation")
+	ErrForeignKeyViolation = errors.New("foreign key violation")
+	ErrCheckViolation      = errors.New("check violation")
+)
+
+type adminKey struct {
+	userID uuid.UUID
+	cityID uuid.UUID
+}
+
+type idempotencyKey struct {
+	userID uuid.UUID
+	key    string
+}
+
+type state struct {
+	cities          map[uuid.UUID]models.City
+	admins          map[adminKey]models.CityAdmin
+	invites         map[uuid.UUID]models.Invite
+	webhooks        map[uuid.UUID]models.Webhook
+	deliveries      map[uuid.UUID]models.WebhookDelivery
+	idempotencyKeys map[idempotencyKey]models.IdempotencyKey
+}
+
+func (s state) clone() state {
+	return state{
+		cities:          maps.Clone(s.cities),
+		admins:          maps.Clone(s.admins),
+		invites:         maps.Clone(s.invites),
+		webhooks:        maps.Clone(s.webhooks),
+		deliveries:      maps.Clone(s.deliveries),
+		idempotencyKeys: maps.Clone(s.idempotencyKeys),
+	}
+}
+
+// DB is safe for concurrent use, but a Transaction is not isolated from
+// callers running outside of it, a rollback discards their writes too.
+type DB struct {
+	mu    sync.Mutex
+	state state
+}
+
+func New() *DB {
+	return &DB{
+		state: state{
+			cities:          make(map[uuid.UUID]models.City),
+			admins:          make(map[adminKey]models.CityAdmin),
+			invites:         make(map[uuid.UUID]models.Invite),
+			webhooks:        make(map[uuid.UUID]models.Webhook),
+			deliveries:      make(map[uuid.UUID]models.WebhookDelivery),
+			idempotencyKeys: make(map[idempotencyKey]models.IdempotencyKey),
+		},
+	}
+}
+
+type txKeyType struct{}
+
+var txKey = txKeyType{}
+
+// Transaction restores the state from before fn if fn returns an error,
+// nested calls join the outer transaction like pgdb does.
+func (db *DB) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey).(bool); ok {
+		return fn(ctx)
+	}
+
+	db.mu.Lock()
+	snapshot := db.state.clone()
+	db.mu.Unlock()
+
+	if err := fn(context.WithValue(ctx, txKey, true)); err != nil {
+		db.mu.Lock()
+		db.state = snapshot
+		db.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+func (db *DB) read(fn func(s state)) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	fn(db.state)
+}
+
+func (db *DB) write(fn func(s state) error) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	return fn(db.state)
+}
+
+// page applies the limit and offset of pagi to items which are already sorted.
+func page[T any](items []T, page, size uint64) []T {
+	limit, offset := pagi.PagConvert(page, size)
+	if offset >= uint64(len(items)) {
+		return []T{}
+	}
+
+	end := uint64(len(items))
+	if limit > 0 && offset+limit < end {
+		end = offset + limit
+	}
+
+	return items[offset:end]
+}
+
+func sortByCreatedAt[T any](items []T, createdAt func(T) time.Time, id func(T) string, asc bool) {
+	sort.Slice(items, func(i, j int) bool {
+		a, b := createdAt(items[i]), createdAt(items[j])
+		if a.Equal(b) {
+			return id(items[i]) < id(items[j])
+		}
+		if asc {
+			return a.Before(b)
+		}
+		return a.After(b)
+	})
+}
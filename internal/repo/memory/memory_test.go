@@ -0,0 +1,196 @@
+package memory
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/google/uuid"
+)
+
+func newCity(t *testing.T, db *DB, status string) models.City {
+	t.Helper()
+
+	now := time.Now().UTC()
+	c, err := db.CreateCity(context.Background(), models.City{
+		ID:        uuid.New(),
+		CountryID: "UKR",
+		Status:    status,
+		Name:      "Kyiv",
+		Timezone:  "Europe/Kyiv",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("create city: %v", err)
+	}
+
+	return c
+}
+
+func newAdmin(cityID uuid.UUID, role string) models.CityAdmin {
+	now := time.Now().UTC()
+	return models.CityAdmin{
+		UserID:    uuid.New(),
+		CityID:    cityID,
+		Role:      role,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+func TestTransactionRollback(t *testing.T) {
+	db := New()
+	ctx := context.Background()
+	c := newCity(t, db, enum.CityStatusSupported)
+
+	a := newAdmin(c.ID, enum.CityAdminRoleMember)
+	errFail := errors.New("fail")
+
+	err := db.Transaction(ctx, func(ctx context.Context) error {
+		if err := db.CreateCityAdmin(ctx, a); err != nil {
+			return err
+		}
+
+		return db.Transaction(ctx, func(ctx context.Context) error {
+			if err := db.UpdateCityStatus(ctx, c.ID, enum.CityStatusSuspended, time.Now()); err != nil {
+				return err
+			}
+			return errFail
+		})
+	})
+	if !errors.Is(err, errFail) {
+		t.Fatalf("expected %v, got %v", errFail, err)
+	}
+
+	got, _ := db.GetCityAdmin(ctx, a.UserID, c.ID)
+	if !got.IsNil() {
+		t.Errorf("admin survived rollback: %+v", got)
+	}
+
+	res, _ := db.GetCityByID(ctx, c.ID)
+	if res.Status != enum.CityStatusSupported {
+		t.Errorf("status = %s after rollback of nested transaction, want %s", res.Status, enum.CityStatusSupported)
+	}
+}
+
+func TestTransactionCommit(t *testing.T) {
+	db := New()
+	ctx := context.Background()
+	c := newCity(t, db, enum.CityStatusSupported)
+
+	a := newAdmin(c.ID, enum.CityAdminRoleMember)
+	if err := db.Transaction(ctx, func(ctx context.Context) error {
+		return db.CreateCityAdmin(ctx, a)
+	}); err != nil {
+		t.Fatalf("transaction: %v", err)
+	}
+
+	got, _ := db.GetCityAdmin(ctx, a.UserID, c.ID)
+	if got.IsNil() {
+		t.Error("admin was not committed")
+	}
+}
+
+func TestConstraints(t *testing.T) {
+	cases := []struct {
+		name string
+		run  func(t *testing.T, db *DB, c models.City) error
+		want error
+	}{
+		{
+			name: "second tech lead",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleTechLead)); err != nil {
+					return err
+				}
+				return db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleTechLead))
+			},
+			want: ErrUniqueViolation,
+		},
+		{
+			name: "second chief",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleChief)); err != nil {
+					return err
+				}
+				return db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleChief))
+			},
+			want: ErrUniqueViolation,
+		},
+		{
+			name: "many moderators",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleModerator)); err != nil {
+					return err
+				}
+				return db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleModerator))
+			},
+		},
+		{
+			name: "admin of unknown city",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				return db.CreateCityAdmin(context.Background(), newAdmin(uuid.New(), enum.CityAdminRoleMember))
+			},
+			want: ErrForeignKeyViolation,
+		},
+		{
+			name: "unsupport city with admins",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.CreateCityAdmin(ctx, newAdmin(c.ID, enum.CityAdminRoleMember)); err != nil {
+					return err
+				}
+				return db.UpdateCityStatus(ctx, c.ID, enum.CityStatusUnsupported, time.Now())
+			},
+			want: ErrCheckViolation,
+		},
+		{
+			name: "invite to suspended city",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.UpdateCityStatus(ctx, c.ID, enum.CityStatusSuspended, time.Now()); err != nil {
+					return err
+				}
+				return db.CreateInvite(ctx, models.Invite{
+					ID:     uuid.New(),
+					CityID: c.ID,
+					UserID: uuid.New(),
+					Role:   enum.CityAdminRoleMember,
+					Status: enum.InviteStatusSent,
+				})
+			},
+			want: ErrCheckViolation,
+		},
+		{
+			name: "taken slug",
+			run: func(t *testing.T, db *DB, c models.City) error {
+				ctx := context.Background()
+				if err := db.UpdateCity(ctx, c.ID, city.UpdateParams{Slug: ptr("kyiv")}, time.Now()); err != nil {
+					return err
+				}
+				other := newCity(t, db, enum.CityStatusSupported)
+				return db.UpdateCity(ctx, other.ID, city.UpdateParams{Slug: ptr("kyiv")}, time.Now())
+			},
+			want: ErrUniqueViolation,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			db := New()
+			c := newCity(t, db, enum.CityStatusSupported)
+
+			if err := tc.run(t, db, c); !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,172 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/google/uuid"
+)
+
+func (db *DB) CreateWebhook(_ context.Context, m models.Webhook) error {
+	return db.write(func(s state) error {
+		if _, ok := s.webhooks[m.ID]; ok {
+			return fmt.Errorf("%w: webhook %s already exists", ErrUniqueViolation, m.ID)
+		}
+
+		s.webhooks[m.ID] = m
+		return nil
+	})
+}
+
+func (db *DB) GetWebhook(_ context.Context, id uuid.UUID) (res models.Webhook, _ error) {
+	db.read(func(s state) {
+		res = s.webhooks[id]
+	})
+
+	return res, nil
+}
+
+func (db *DB) GetWebhooks(_ context.Context) ([]models.Webhook, error) {
+	res := make([]models.Webhook, 0)
+	db.read(func(s state) {
+		for _, w := range s.webhooks {
+			res = append(res, w)
+		}
+	})
+
+	sortByCreatedAt(res,
+		func(w models.Webhook) time.Time { return w.CreatedAt },
+		func(w models.Webhook) string { return w.ID.String() },
+		true,
+	)
+
+	return res, nil
+}
+
+// DeleteWebhook removes the deliveries of the webhook too, like the cascading
+// foreign key of the schema.
+func (db *DB) DeleteWebhook(_ context.Context, id uuid.UUID) error {
+	return db.write(func(s state) error {
+		delete(s.webhooks, id)
+		for key, d := range s.deliveries {
+			if d.WebhookID == id {
+				delete(s.deliveries, key)
+			}
+		}
+		return nil
+	})
+}
+
+func (db *DB) CreateWebhookDelivery(_ context.Context, m models.WebhookDelivery) error {
+	return db.write(func(s state) error {
+		if _, ok := s.deliveries[m.ID]; ok {
+			return fmt.Errorf("%w: webhook delivery %s already exists", ErrUniqueViolation, m.ID)
+		}
+		if _, ok := s.webhooks[m.WebhookID]; !ok {
+			return fmt.Errorf("%w: webhook %s does not exist", ErrForeignKeyViolation, m.WebhookID)
+		}
+
+		s.deliveries[m.ID] = m
+		return nil
+	})
+}
+
+func (db *DB) GetWebhookDelivery(_ context.Context, id uuid.UUID) (res models.WebhookDelivery, _ error) {
+	db.read(func(s state) {
+		res = s.deliveries[id]
+	})
+
+	return res, nil
+}
+
+func (db *DB) FilterWebhookDeliveries(
+	_ context.Context,
+	filter webhook.FilterDeliveriesParams,
+	pageNum, size uint64,
+) (models.WebhookDeliveriesCollection, error) {
+	res := make([]models.WebhookDelivery, 0)
+	db.read(func(s state) {
+		for _, d := range s.deliveries {
+			if filter.WebhookID != nil && !slices.Contains(filter.WebhookID, d.WebhookID) {
+				continue
+			}
+			if filter.Status != nil && !slices.Contains(filter.Status, d.Status) {
+				continue
+			}
+			res = append(res, d)
+		}
+	})
+
+	sortByCreatedAt(res,
+		func(d models.WebhookDelivery) time.Time { return d.CreatedAt },
+		func(d models.WebhookDelivery) string { return d.ID.String() },
+		false,
+	)
+
+	return models.WebhookDeliveriesCollection{
+		Data:  page(res, pageNum, size),
+		Page:  pageNum,
+		Size:  size,
+		Total: uint64(len(res)),
+	}, nil
+}
+
+func (db *DB) ClaimWebhookDeliveries(
+	_ context.Context,
+	now, until time.Time,
+	limit uint64,
+) ([]models.WebhookDelivery, error) {
+	res := make([]models.WebhookDelivery, 0)
+	err := db.write(func(s state) error {
+		for _, d := range s.deliveries {
+			if d.Status == enum.WebhookDeliveryStatusPending && !d.NextAttemptAt.After(now) {
+				res = append(res, d)
+			}
+		}
+
+		sort.Slice(res, func(i, j int) bool {
+			return res[i].NextAttemptAt.Before(res[j].NextAttemptAt)
+		})
+		if uint64(len(res)) > limit {
+			res = res[:limit]
+		}
+
+		for i := range res {
+			res[i].NextAttemptAt = until
+			res[i].UpdatedAt = now
+			s.deliveries[res[i].ID] = res[i]
+		}
+		return nil
+	})
+
+	return res, err
+}
+
+func (db *DB) UpdateWebhookDelivery(
+	_ context.Context,
+	id uuid.UUID,
+	params webhook.UpdateDeliveryParams,
+	updatedAt time.Time,
+) error {
+	return db.write(func(s state) error {
+		d, ok := s.deliveries[id]
+		if !ok {
+			return nil
+		}
+
+		d.Status = params.Status
+		d.Attempts = params.Attempts
+		d.ResponseCode = params.ResponseCode
+		d.LastError = params.LastError
+		d.NextAttemptAt = params.NextAttemptAt
+		d.UpdatedAt = updatedAt
+		s.deliveries[id] = d
+		return nil
+	})
+}
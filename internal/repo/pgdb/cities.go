@@ -12,7 +12,7 @@ import (
 	"github.com/paulmach/orb"
 )
 
-const citiesTable = "city"
+const citiesTable = "cities"
 
 type City struct {
 	ID        uuid.UUID
@@ -29,7 +29,7 @@ type City struct {
 }
 
 type CitiesQ struct {
-	db       *sql.DB
+	conn     conn
 	selector sq.SelectBuilder
 	updater  sq.UpdateBuilder
 	inserter sq.InsertBuilder
@@ -37,10 +37,10 @@ type CitiesQ struct {
 	counter  sq.SelectBuilder
 }
 
-func NewCitiesQ(db *sql.DB) CitiesQ {
+func NewCitiesQ(db *sql.DB, hooks ...QueryHook) CitiesQ {
 	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	return CitiesQ{
-		db: db,
+		conn: conn{db: db, hooks: hooks},
 		selector: b.Select(
 			"id",
 			"country_id",
@@ -61,7 +61,7 @@ func NewCitiesQ(db *sql.DB) CitiesQ {
 	}
 }
 
-func (q CitiesQ) New() CitiesQ { return NewCitiesQ(q.db) }
+func (q CitiesQ) New() CitiesQ { return NewCitiesQ(q.conn.db, q.conn.hooks...) }
 
 func scanCityRow(scanner interface{ Scan(dest ...any) error }) (City, error) {
 	var (
@@ -110,12 +110,7 @@ func (q CitiesQ) Insert(ctx context.Context, in City) error {
 	if err != nil {
 		return fmt.Errorf("build insert %s: %w", citiesTable, err)
 	}
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, qry, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, qry, args...)
-	}
-	return err
+	return q.conn.exec(ctx, citiesTable, "insert", qry, args...)
 }
 
 func (q CitiesQ) Select(ctx context.Context) ([]City, error) {
@@ -123,25 +118,20 @@ func (q CitiesQ) Select(ctx context.Context) ([]City, error) {
 	if err != nil {
 		return nil, fmt.Errorf("build select %s: %w", citiesTable, err)
 	}
-	var rows *sql.Rows
-	if tx, ok := TxFromCtx(ctx); ok {
-		rows, err = tx.QueryContext(ctx, qry, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, qry, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
 
 	var out []City
-	for rows.Next() {
+	err = q.conn.query(ctx, citiesTable, "select", qry, args, func(rows *sql.Rows) error {
 		c, err := scanCityRow(rows)
 		if err != nil {
-			return nil, fmt.Errorf("scan %s: %w", citiesTable, err)
+			return fmt.Errorf("scan %s: %w", citiesTable, err)
 		}
 		out = append(out, c)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	return out, nil
 }
 
@@ -150,13 +140,12 @@ func (q CitiesQ) Get(ctx context.Context) (City, error) {
 	if err != nil {
 		return City{}, fmt.Errorf("build select %s: %w", citiesTable, err)
 	}
-	var row *sql.Row
-	if tx, ok := TxFromCtx(ctx); ok {
-		row = tx.QueryRowContext(ctx, qry, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, qry, args...)
-	}
-	return scanCityRow(row)
+	var c City
+	err = q.conn.queryRow(ctx, citiesTable, "get", qry, args, func(row *sql.Row) (err error) {
+		c, err = scanCityRow(row)
+		return err
+	})
+	return c, err
 }
 
 func (q CitiesQ) Update(ctx context.Context, updatedAt time.Time) error {
@@ -166,13 +155,7 @@ func (q CitiesQ) Update(ctx context.Context, updatedAt time.Time) error {
 	if err != nil {
 		return fmt.Errorf("building update query for %s: %w", citiesTable, err)
 	}
-
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.conn.exec(ctx, citiesTable, "update", query, args...)
 }
 
 func (q CitiesQ) UpdateCountryID(countryID string) CitiesQ {
@@ -226,15 +209,10 @@ func (q CitiesQ) Delete(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("build delete %s: %w", citiesTable, err)
 	}
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, qry, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, qry, args...)
-	}
-	return err
+	return q.conn.exec(ctx, citiesTable, "delete", qry, args...)
 }
 
-func (q CitiesQ) FilterID(id uuid.UUID) CitiesQ {
+func (q CitiesQ) FilterID(id ...uuid.UUID) CitiesQ {
 	q.selector = q.selector.Where(sq.Eq{"id": id})
 	q.counter = q.counter.Where(sq.Eq{"id": id})
 	q.updater = q.updater.Where(sq.Eq{"id": id})
@@ -301,11 +279,9 @@ func (q CitiesQ) OrderByNearest(point orb.Point, asc bool) CitiesQ {
 		dir = "ASC"
 	}
 
-	q.selector = q.selector.SuffixExpr(
-		sq.Expr(
-			fmt.Sprintf("ORDER BY point <-> ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography %s", dir),
-			point[0], point[1],
-		),
+	q.selector = q.selector.OrderByClause(
+		fmt.Sprintf("point <-> ST_SetSRID(ST_MakePoint(?, ?), 4326)::geography %s", dir),
+		point[0], point[1],
 	)
 	return q
 }
@@ -321,24 +297,25 @@ func (q CitiesQ) Count(ctx context.Context) (uint64, error) {
 		return 0, fmt.Errorf("build count %s: %w", citiesTable, err)
 	}
 	var n uint64
-	if tx, ok := TxFromCtx(ctx); ok {
-		err = tx.QueryRowContext(ctx, qry, args...).Scan(&n)
-	} else {
-		err = q.db.QueryRowContext(ctx, qry, args...).Scan(&n)
-	}
+	err = q.conn.queryRow(ctx, citiesTable, "count", qry, args, func(row *sql.Row) error {
+		return row.Scan(&n)
+	})
 	if err != nil {
 		return 0, fmt.Errorf("scan count %s: %w", citiesTable, err)
 	}
 	return n, nil
 }
 
-func (q CitiesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
+func (q CitiesQ) Transaction(ctx context.Context, fn func(ctx context.Context) error) (err error) {
 	_, ok := TxFromCtx(ctx)
 	if ok {
 		return fn(ctx)
 	}
 
-	tx, err := q.db.BeginTx(ctx, nil)
+	ctx = q.conn.before(ctx, txTable, "transaction")
+	defer func() { q.conn.after(ctx, txTable, "transaction", err) }()
+
+	tx, err := q.conn.db.BeginTx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to start transaction: %w", err)
 	}
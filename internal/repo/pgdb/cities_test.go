@@ -0,0 +1,299 @@
+package pgdb_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+var (
+	kyiv    = orb.Point{30.5234, 50.4501}
+	brovary = orb.Point{30.7909, 50.5110}
+	lviv    = orb.Point{24.0297, 49.8397}
+)
+
+func names(cities []pgdb.City) []string {
+	res := make([]string, len(cities))
+	for i, c := range cities {
+		res[i] = c.Name
+	}
+	return res
+}
+
+func equalNames(t *testing.T, got []pgdb.City, want ...string) {
+	t.Helper()
+
+	if n := names(got); !slices.Equal(n, want) {
+		t.Fatalf("got %v, want %v", n, want)
+	}
+}
+
+func TestCitiesInsertGet(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	want := insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) {
+		c.Slug = ptr("kyiv-get")
+		c.Icon = ptr("https://example.com/kyiv.png")
+	})
+
+	got, err := pgdb.NewCitiesQ(db).FilterID(want.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+
+	if math.Abs(got.Point[0]-want.Point[0]) > 1e-9 || math.Abs(got.Point[1]-want.Point[1]) > 1e-9 {
+		t.Errorf("point = %v, want %v", got.Point, want.Point)
+	}
+	got.Point = want.Point
+	if got.ID != want.ID || got.Name != want.Name || got.CountryID != want.CountryID ||
+		got.Status != want.Status || got.Timezone != want.Timezone ||
+		*got.Slug != *want.Slug || *got.Icon != *want.Icon ||
+		!got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err = pgdb.NewCitiesQ(db).FilterID(uuid.New()).Get(ctx); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected %v for unknown id, got %v", sql.ErrNoRows, err)
+	}
+}
+
+func TestCitiesFilters(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) { c.Slug = ptr("kyiv-filter") })
+	insertCity(t, ctx, db, "Brovary", brovary, func(c *pgdb.City) { c.Status = "suspended" })
+	insertCity(t, ctx, db, "Lviv", lviv)
+	insertCity(t, ctx, db, "Warsaw", orb.Point{21.0122, 52.2297}, func(c *pgdb.City) {
+		c.CountryID = "POL"
+		c.Timezone = "Europe/Warsaw"
+	})
+
+	cases := []struct {
+		name  string
+		query func(q pgdb.CitiesQ) pgdb.CitiesQ
+		want  []string
+		total int
+	}{
+		{name: "id", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterID(k.ID) }, want: []string{"Kyiv"}},
+		{name: "country", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterCountryID("POL") }, want: []string{"Warsaw"}},
+		{name: "status", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterStatus("suspended") }, want: []string{"Brovary"}},
+		{name: "slug", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterSlug("kyiv-filter") }, want: []string{"Kyiv"}},
+		{name: "name like", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterNameLike("V") }, want: []string{"Brovary", "Kyiv", "Lviv"}},
+		{name: "radius", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterWithinRadiusMeters(kyiv, 50_000) }, want: []string{"Brovary", "Kyiv"}},
+		{name: "alphabetical desc", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.FilterCountryID("UKR").OrderByAlphabetical(false) }, want: []string{"Lviv", "Kyiv", "Brovary"}},
+		{name: "nearest", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.OrderByNearest(kyiv, true) }, want: []string{"Kyiv", "Brovary", "Lviv", "Warsaw"}},
+		{name: "farthest", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.OrderByNearest(kyiv, false) }, want: []string{"Warsaw", "Lviv", "Brovary", "Kyiv"}},
+		{name: "page", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.OrderByAlphabetical(true).Page(2, 1) }, want: []string{"Kyiv", "Lviv"}, total: 4},
+		{name: "nearest page", query: func(q pgdb.CitiesQ) pgdb.CitiesQ { return q.OrderByNearest(lviv, true).Page(1, 0) }, want: []string{"Lviv"}, total: 4},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.query(pgdb.NewCitiesQ(db)).OrderByAlphabetical(true).Select(ctx)
+			if err != nil {
+				t.Fatalf("select: %v", err)
+			}
+			equalNames(t, got, tc.want...)
+
+			n, err := tc.query(pgdb.NewCitiesQ(db)).Count(ctx)
+			if err != nil {
+				t.Fatalf("count: %v", err)
+			}
+			total := tc.total
+			if total == 0 {
+				total = len(tc.want)
+			}
+			if n != uint64(total) {
+				t.Errorf("count = %d, want %d", n, total)
+			}
+		})
+	}
+}
+
+func TestCitiesUpdate(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kiev", kyiv, func(c *pgdb.City) { c.Slug = ptr("kiev-update") })
+	other := insertCity(t, ctx, db, "Lviv", lviv)
+
+	updatedAt := now()
+	err := pgdb.NewCitiesQ(db).
+		FilterID(c.ID).
+		UpdateName("Kyiv").
+		UpdateCountryID("UKR").
+		UpdatePoint(brovary).
+		UpdateStatus("suspended").
+		UpdateIcon(sql.NullString{String: "icon.png", Valid: true}).
+		UpdateSlug(sql.NullString{}).
+		UpdateTimezone("UTC").
+		Update(ctx, updatedAt)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := pgdb.NewCitiesQ(db).FilterID(c.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Name != "Kyiv" || got.Status != "suspended" || got.Timezone != "UTC" ||
+		got.Slug != nil || got.Icon == nil || *got.Icon != "icon.png" ||
+		math.Abs(got.Point[0]-brovary[0]) > 1e-9 || !got.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("unexpected city after update %+v", got)
+	}
+
+	untouched, err := pgdb.NewCitiesQ(db).FilterID(other.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get other: %v", err)
+	}
+	if untouched.Name != "Lviv" || !untouched.UpdatedAt.Equal(other.UpdatedAt) {
+		t.Errorf("update leaked to another city: %+v", untouched)
+	}
+}
+
+func TestCitiesDelete(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	insertAdmin(t, ctx, db, c.ID, "member")
+	insertInvite(t, ctx, db, c.ID)
+	l := insertCity(t, ctx, db, "Lviv", lviv)
+
+	if err := pgdb.NewCitiesQ(db).FilterID(c.ID).Delete(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	n, err := pgdb.NewCitiesQ(db).FilterID(c.ID, l.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("cities = %d, want 1", n)
+	}
+
+	admins, err := pgdb.NewCityAdminsQ(db).FilterCityID(c.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count admins: %v", err)
+	}
+	invites, err := pgdb.NewInvitesQ(db).FilterCityID(c.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count invites: %v", err)
+	}
+	if admins != 0 || invites != 0 {
+		t.Errorf("admins = %d, invites = %d after cascade, want 0", admins, invites)
+	}
+}
+
+func TestCitiesConstraints(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+
+	t.Run("unique slug", func(t *testing.T) {
+		ctx := pgtest.Tx(t)
+		insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) { c.Slug = ptr("unique-slug") })
+
+		err := pgdb.NewCitiesQ(db).Insert(ctx, pgdb.City{
+			ID: uuid.New(), CountryID: "UKR", Point: lviv, Status: "supported",
+			Name: "Lviv", Slug: ptr("unique-slug"), Timezone: "Europe/Kyiv",
+			CreatedAt: now(), UpdatedAt: now(),
+		})
+		if err == nil {
+			t.Fatal("expected unique violation")
+		}
+	})
+
+	t.Run("unsupported with admins", func(t *testing.T) {
+		ctx := pgtest.Tx(t)
+		c := insertCity(t, ctx, db, "Kyiv", kyiv)
+		insertAdmin(t, ctx, db, c.ID, "member")
+
+		err := pgdb.NewCitiesQ(db).FilterID(c.ID).UpdateStatus("unsupported").Update(ctx, now())
+		if err == nil {
+			t.Fatal("expected the status trigger to reject the update")
+		}
+	})
+}
+
+func TestCitiesTransaction(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	errFail := errors.New("fail")
+
+	t.Run("rollback", func(t *testing.T) {
+		id := uuid.New()
+		err := pgdb.NewCitiesQ(db).Transaction(context.Background(), func(ctx context.Context) error {
+			insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) { c.ID = id })
+			return errFail
+		})
+		if !errors.Is(err, errFail) {
+			t.Fatalf("expected %v, got %v", errFail, err)
+		}
+
+		n, err := pgdb.NewCitiesQ(db).FilterID(id).Count(context.Background())
+		if err != nil {
+			t.Fatalf("count: %v", err)
+		}
+		if n != 0 {
+			t.Errorf("city survived rollback")
+		}
+	})
+
+	t.Run("joins outer transaction", func(t *testing.T) {
+		ctx := pgtest.Tx(t)
+		outer, _ := pgdb.TxFromCtx(ctx)
+
+		err := pgdb.NewCitiesQ(db).Transaction(ctx, func(ctx context.Context) error {
+			if inner, _ := pgdb.TxFromCtx(ctx); inner != outer {
+				t.Error("nested transaction did not join the outer one")
+			}
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("transaction: %v", err)
+		}
+	})
+}
+
+type recordingHook struct {
+	ops []string
+}
+
+func (h *recordingHook) BeforeQuery(ctx context.Context, table, op string) context.Context {
+	h.ops = append(h.ops, "before "+table+" "+op)
+	return ctx
+}
+
+func (h *recordingHook) AfterQuery(_ context.Context, table, op string, _ error) {
+	h.ops = append(h.ops, "after "+table+" "+op)
+}
+
+func TestQueryHooks(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	h := &recordingHook{}
+	if _, err := pgdb.NewCitiesQ(db, h).New().Count(ctx); err != nil {
+		t.Fatalf("count: %v", err)
+	}
+
+	want := []string{"before cities count", "after cities count"}
+	if len(h.ops) != len(want) || h.ops[0] != want[0] || h.ops[1] != want[1] {
+		t.Errorf("hooks = %v, want %v", h.ops, want)
+	}
+}
@@ -10,7 +10,7 @@ import (
 	"github.com/google/uuid"
 )
 
-const CityAdminsTable = "city_admins"
+const CityAdminsTable = "city_administration"
 
 type CityAdmin struct {
 	UserID    uuid.UUID `db:"user_id"`
@@ -23,7 +23,7 @@ type CityAdmin struct {
 }
 
 type CityAdminsQ struct {
-	db       *sql.DB
+	conn     conn
 	selector sq.SelectBuilder
 	inserter sq.InsertBuilder
 	updater  sq.UpdateBuilder
@@ -31,7 +31,7 @@ type CityAdminsQ struct {
 	counter  sq.SelectBuilder
 }
 
-func NewCityAdminsQ(db *sql.DB) CityAdminsQ {
+func NewCityAdminsQ(db *sql.DB, hooks ...QueryHook) CityAdminsQ {
 	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 
 	cols := []string{
@@ -45,7 +45,7 @@ func NewCityAdminsQ(db *sql.DB) CityAdminsQ {
 	}
 
 	return CityAdminsQ{
-		db:       db,
+		conn:     conn{db: db, hooks: hooks},
 		selector: b.Select(cols...).From(CityAdminsTable),
 		inserter: b.Insert(CityAdminsTable),
 		updater:  b.Update(CityAdminsTable),
@@ -54,7 +54,7 @@ func NewCityAdminsQ(db *sql.DB) CityAdminsQ {
 	}
 }
 
-func (q CityAdminsQ) New() CityAdminsQ { return NewCityAdminsQ(q.db) }
+func (q CityAdminsQ) New() CityAdminsQ { return NewCityAdminsQ(q.conn.db, q.conn.hooks...) }
 
 func (q CityAdminsQ) Insert(ctx context.Context, in CityAdmin) error {
 	values := map[string]interface{}{
@@ -81,13 +81,7 @@ func (q CityAdminsQ) Insert(ctx context.Context, in CityAdmin) error {
 		return fmt.Errorf("building insert query for %s: %w", CityAdminsTable, err)
 	}
 
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-
-	return err
+	return q.conn.exec(ctx, CityAdminsTable, "insert", query, args...)
 }
 
 func (q CityAdminsQ) Get(ctx context.Context) (CityAdmin, error) {
@@ -97,21 +91,17 @@ func (q CityAdminsQ) Get(ctx context.Context) (CityAdmin, error) {
 	}
 
 	var m CityAdmin
-	var row *sql.Row
-	if tx, ok := TxFromCtx(ctx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
-	err = row.Scan(
-		&m.UserID,
-		&m.CityID,
-		&m.Role,
-		&m.Position,
-		&m.Label,
-		&m.CreatedAt,
-		&m.UpdatedAt,
-	)
+	err = q.conn.queryRow(ctx, CityAdminsTable, "get", query, args, func(row *sql.Row) error {
+		return row.Scan(
+			&m.UserID,
+			&m.CityID,
+			&m.Role,
+			&m.Position,
+			&m.Label,
+			&m.CreatedAt,
+			&m.UpdatedAt,
+		)
+	})
 	return m, err
 }
 
@@ -121,19 +111,8 @@ func (q CityAdminsQ) Select(ctx context.Context) ([]CityAdmin, error) {
 		return nil, fmt.Errorf("building select query for %s: %w", CityAdminsTable, err)
 	}
 
-	var rows *sql.Rows
-	if tx, ok := TxFromCtx(ctx); ok {
-		rows, err = tx.QueryContext(ctx, query, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, query, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
 	var out []CityAdmin
-	for rows.Next() {
+	err = q.conn.query(ctx, CityAdminsTable, "select", query, args, func(rows *sql.Rows) error {
 		var m CityAdmin
 		if err = rows.Scan(
 			&m.UserID,
@@ -144,10 +123,15 @@ func (q CityAdminsQ) Select(ctx context.Context) ([]CityAdmin, error) {
 			&m.CreatedAt,
 			&m.UpdatedAt,
 		); err != nil {
-			return nil, err
+			return err
 		}
 		out = append(out, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	return out, nil
 }
 
@@ -159,12 +143,7 @@ func (q CityAdminsQ) Update(ctx context.Context, updatedAt time.Time) error {
 		return fmt.Errorf("building update query for %s: %w", CityAdminsTable, err)
 	}
 
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.conn.exec(ctx, CityAdminsTable, "update", query, args...)
 }
 
 func (q CityAdminsQ) UpdateCityID(cityID uuid.UUID) CityAdminsQ {
@@ -172,11 +151,6 @@ func (q CityAdminsQ) UpdateCityID(cityID uuid.UUID) CityAdminsQ {
 	return q
 }
 
-func (q CityAdminsQ) UpdateStatus(status string) CityAdminsQ {
-	q.updater = q.updater.Set("status", status)
-	return q
-}
-
 func (q CityAdminsQ) UpdateRole(role string) CityAdminsQ {
 	q.updater = q.updater.Set("role", role)
 	return q
@@ -198,12 +172,7 @@ func (q CityAdminsQ) Delete(ctx context.Context) error {
 		return fmt.Errorf("building delete query for %s: %w", CityAdminsTable, err)
 	}
 
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
-	}
-	return err
+	return q.conn.exec(ctx, CityAdminsTable, "delete", query, args...)
 }
 
 func (q CityAdminsQ) FilterUserID(userID ...uuid.UUID) CityAdminsQ {
@@ -222,6 +191,19 @@ func (q CityAdminsQ) FilterCityID(cityID ...uuid.UUID) CityAdminsQ {
 	return q
 }
 
+// FilterUserCityPairs matches rows equal to any of the given {user_id, city_id} pairs.
+func (q CityAdminsQ) FilterUserCityPairs(pairs ...[2]uuid.UUID) CityAdminsQ {
+	cond := sq.Or{}
+	for _, p := range pairs {
+		cond = append(cond, sq.Eq{"user_id": p[0], "city_id": p[1]})
+	}
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.counter = q.counter.Where(cond)
+	return q
+}
+
 func (q CityAdminsQ) FilterRole(role ...string) CityAdminsQ {
 	q.selector = q.selector.Where(sq.Eq{"role": role})
 	q.deleter = q.deleter.Where(sq.Eq{"role": role})
@@ -230,12 +212,8 @@ func (q CityAdminsQ) FilterRole(role ...string) CityAdminsQ {
 	return q
 }
 
-// FilterCountryID Unsupported: this method is commented out and should not be used.
+// FilterCountryID matches admins of the cities in the given country.
 func (q CityAdminsQ) FilterCountryID(countryID string) CityAdminsQ {
-	join := fmt.Sprintf("LEFT JOIN %s c ON c.id = cg.city_id", citiesTable)
-	q.selector = q.selector.LeftJoin(join).Where(sq.Eq{"c.country_id": countryID})
-	q.counter = q.counter.LeftJoin(join).Where(sq.Eq{"c.country_id": countryID})
-
 	sub := sq.
 		Select("1").
 		From(citiesTable + " c").
@@ -243,10 +221,12 @@ func (q CityAdminsQ) FilterCountryID(countryID string) CityAdminsQ {
 		Where(sq.Eq{"c.country_id": countryID})
 
 	subSQL, subArgs, _ := sub.ToSql()
+	cond := sq.Expr("EXISTS ("+subSQL+")", subArgs...)
 
-	q.updater = q.updater.Where(sq.Expr("EXISTS ("+subSQL+")", subArgs...))
-	q.deleter = q.deleter.Where(sq.Expr("EXISTS ("+subSQL+")", subArgs...))
-
+	q.selector = q.selector.Where(cond)
+	q.deleter = q.deleter.Where(cond)
+	q.updater = q.updater.Where(cond)
+	q.counter = q.counter.Where(cond)
 	return q
 }
 
@@ -300,13 +280,10 @@ func (q CityAdminsQ) Count(ctx context.Context) (uint64, error) {
 	}
 
 	var n uint64
-	var row *sql.Row
-	if tx, ok := TxFromCtx(ctx); ok {
-		row = tx.QueryRowContext(ctx, query, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, query, args...)
-	}
-	if err := row.Scan(&n); err != nil {
+	err = q.conn.queryRow(ctx, CityAdminsTable, "count", query, args, func(row *sql.Row) error {
+		return row.Scan(&n)
+	})
+	if err != nil {
 		return 0, fmt.Errorf("scanning count result: %w", err)
 	}
 	return n, nil
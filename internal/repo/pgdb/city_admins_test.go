@@ -0,0 +1,232 @@
+package pgdb_test
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+func roles(admins []pgdb.CityAdmin) []string {
+	res := make([]string, len(admins))
+	for i, a := range admins {
+		res[i] = a.Role
+	}
+	return res
+}
+
+func TestCityAdminsInsertGet(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	want := insertAdmin(t, ctx, db, c.ID, "chief", func(a *pgdb.CityAdmin) {
+		a.Label = ptr("mayor")
+		a.Position = ptr("head of the city")
+	})
+
+	got, err := pgdb.NewCityAdminsQ(db).FilterUserID(want.UserID).FilterCityID(c.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.UserID != want.UserID || got.CityID != want.CityID || got.Role != want.Role ||
+		*got.Label != *want.Label || *got.Position != *want.Position ||
+		!got.CreatedAt.Equal(want.CreatedAt) || !got.UpdatedAt.Equal(want.UpdatedAt) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err = pgdb.NewCityAdminsQ(db).FilterUserID(uuid.New()).Get(ctx); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected %v for unknown admin, got %v", sql.ErrNoRows, err)
+	}
+}
+
+func TestCityAdminsDefaults(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	a := insertAdmin(t, ctx, db, c.ID, "member", func(a *pgdb.CityAdmin) {
+		a.CreatedAt = time.Time{}
+		a.UpdatedAt = time.Time{}
+	})
+
+	got, err := pgdb.NewCityAdminsQ(db).FilterUserID(a.UserID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.CreatedAt.IsZero() || got.UpdatedAt.IsZero() || got.Label != nil || got.Position != nil {
+		t.Errorf("unexpected defaults %+v", got)
+	}
+}
+
+func TestCityAdminsFilters(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertCity(t, ctx, db, "Kyiv", kyiv)
+	w := insertCity(t, ctx, db, "Warsaw", orb.Point{21.0122, 52.2297}, func(c *pgdb.City) { c.CountryID = "POL" })
+
+	base := now()
+	at := func(d time.Duration) func(a *pgdb.CityAdmin) {
+		return func(a *pgdb.CityAdmin) {
+			a.CreatedAt = base.Add(d)
+			a.UpdatedAt = base.Add(-d)
+		}
+	}
+
+	lead := insertAdmin(t, ctx, db, k.ID, "tech-lead", at(0), func(a *pgdb.CityAdmin) { a.Label = ptr("Backend") })
+	moder := insertAdmin(t, ctx, db, k.ID, "moderator", at(time.Second), func(a *pgdb.CityAdmin) { a.Position = ptr("Press office") })
+	chief := insertAdmin(t, ctx, db, k.ID, "chief", at(2*time.Second))
+	insertAdmin(t, ctx, db, w.ID, "member", at(3*time.Second))
+
+	cities := []uuid.UUID{k.ID, w.ID}
+
+	cases := []struct {
+		name  string
+		query func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ
+		want  []string
+		total int
+	}{
+		{name: "user", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterUserID(lead.UserID, chief.UserID) }, want: []string{"tech-lead", "chief"}},
+		{name: "city", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterCityID(w.ID) }, want: []string{"member"}},
+		{name: "pairs", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ {
+			return q.FilterUserCityPairs([2]uuid.UUID{moder.UserID, k.ID}, [2]uuid.UUID{lead.UserID, w.ID})
+		}, want: []string{"moderator"}},
+		{name: "role", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterRole("chief", "member") }, want: []string{"chief", "member"}},
+		{name: "country", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterCountryID("POL") }, want: []string{"member"}},
+		{name: "label like", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterLabelLike("back") }, want: []string{"tech-lead"}},
+		{name: "position like", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.FilterPositionLike("PRESS") }, want: []string{"moderator"}},
+		{name: "role order", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.OrderByRole(true) }, want: []string{"chief", "member", "tech-lead", "moderator"}},
+		{name: "updated order", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.OrderByUpdatedAt(true) }, want: []string{"member", "chief", "moderator", "tech-lead"}},
+		{name: "created order desc", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.OrderByCreatedAt(false) }, want: []string{"member", "chief", "moderator", "tech-lead"}},
+		{name: "page", query: func(q pgdb.CityAdminsQ) pgdb.CityAdminsQ { return q.OrderByRole(false).Page(2, 1) }, want: []string{"tech-lead", "member"}, total: 4},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.query(pgdb.NewCityAdminsQ(db).FilterCityID(cities...)).OrderByCreatedAt(true).Select(ctx)
+			if err != nil {
+				t.Fatalf("select: %v", err)
+			}
+			if r := roles(got); !slices.Equal(r, tc.want) {
+				t.Fatalf("got %v, want %v", r, tc.want)
+			}
+
+			n, err := tc.query(pgdb.NewCityAdminsQ(db).FilterCityID(cities...)).Count(ctx)
+			if err != nil {
+				t.Fatalf("count: %v", err)
+			}
+			total := tc.total
+			if total == 0 {
+				total = len(tc.want)
+			}
+			if n != uint64(total) {
+				t.Errorf("count = %d, want %d", n, total)
+			}
+		})
+	}
+}
+
+func TestCityAdminsUpdate(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertCity(t, ctx, db, "Kyiv", kyiv)
+	l := insertCity(t, ctx, db, "Lviv", lviv)
+	a := insertAdmin(t, ctx, db, k.ID, "member", func(a *pgdb.CityAdmin) { a.Label = ptr("old") })
+	other := insertAdmin(t, ctx, db, k.ID, "member")
+
+	updatedAt := now().Add(time.Minute)
+	err := pgdb.NewCityAdminsQ(db).
+		FilterUserID(a.UserID).
+		FilterCityID(k.ID).
+		UpdateRole("moderator").
+		UpdateLabel(sql.NullString{}).
+		UpdatePosition(sql.NullString{String: "Press office", Valid: true}).
+		UpdateCityID(l.ID).
+		Update(ctx, updatedAt)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := pgdb.NewCityAdminsQ(db).FilterUserID(a.UserID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.CityID != l.ID || got.Role != "moderator" || got.Label != nil ||
+		got.Position == nil || *got.Position != "Press office" || !got.UpdatedAt.Equal(updatedAt) {
+		t.Errorf("unexpected admin after update %+v", got)
+	}
+
+	untouched, err := pgdb.NewCityAdminsQ(db).FilterUserID(other.UserID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get other: %v", err)
+	}
+	if untouched.Role != "member" || untouched.CityID != k.ID {
+		t.Errorf("update leaked to another admin: %+v", untouched)
+	}
+}
+
+func TestCityAdminsDelete(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertCity(t, ctx, db, "Kyiv", kyiv)
+	a := insertAdmin(t, ctx, db, k.ID, "member")
+	insertAdmin(t, ctx, db, k.ID, "moderator")
+
+	if err := pgdb.NewCityAdminsQ(db).FilterUserID(a.UserID).FilterCityID(k.ID).Delete(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	n, err := pgdb.NewCityAdminsQ(db).FilterCityID(k.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("admins = %d, want 1", n)
+	}
+}
+
+func TestCityAdminsConstraints(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+
+	cases := []struct {
+		name   string
+		status string
+		first  string
+		second string
+	}{
+		{name: "second chief", status: "supported", first: "chief", second: "chief"},
+		{name: "second tech lead", status: "supported", first: "tech-lead", second: "tech-lead"},
+		{name: "suspended city", status: "suspended", second: "member"},
+		{name: "unsupported city", status: "unsupported", second: "member"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := pgtest.Tx(t)
+			c := insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) { c.Status = tc.status })
+			if tc.first != "" {
+				insertAdmin(t, ctx, db, c.ID, tc.first)
+			}
+
+			err := pgdb.NewCityAdminsQ(db).Insert(ctx, pgdb.CityAdmin{UserID: uuid.New(), CityID: c.ID, Role: tc.second})
+			if err == nil {
+				t.Fatal("expected the insert to be rejected")
+			}
+		})
+	}
+}
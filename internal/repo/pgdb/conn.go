@@ -0,0 +1,112 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+)
+
+// QueryHook is notified around every statement executed by the query builders.
+// BeforeQuery may return a derived context which is used for the statement and
+// passed back to AfterQuery.
+type QueryHook interface {
+	BeforeQuery(ctx context.Context, table, op string) context.Context
+	AfterQuery(ctx context.Context, table, op string, err error)
+}
+
+// txTable is the table label of hooks around a whole transaction.
+const txTable = "tx"
+
+type conn struct {
+	db    *sql.DB
+	hooks []QueryHook
+}
+
+func (c conn) before(ctx context.Context, table, op string) context.Context {
+	for _, h := range c.hooks {
+		ctx = h.BeforeQuery(ctx, table, op)
+	}
+	return ctx
+}
+
+func (c conn) after(ctx context.Context, table, op string, err error) {
+	for i := len(c.hooks) - 1; i >= 0; i-- {
+		c.hooks[i].AfterQuery(ctx, table, op, err)
+	}
+}
+
+func (c conn) exec(ctx context.Context, table, op, query string, args ...any) error {
+	_, err := c.execAffected(ctx, table, op, query, args...)
+	return err
+}
+
+// execAffected runs a statement and returns the number of affected rows.
+func (c conn) execAffected(ctx context.Context, table, op, query string, args ...any) (affected int64, err error) {
+	ctx = c.before(ctx, table, op)
+	defer func() { c.after(ctx, table, op, err) }()
+
+	var res sql.Result
+	if tx, ok := TxFromCtx(ctx); ok {
+		res, err = tx.ExecContext(ctx, query, args...)
+	} else {
+		res, err = c.db.ExecContext(ctx, query, args...)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+// query runs a select and calls scan for every returned row. The after hooks
+// run once the rows are iterated and closed, so they cover the whole read and
+// see errors met while iterating.
+func (c conn) query(
+	ctx context.Context,
+	table, op, query string,
+	args []any,
+	scan func(rows *sql.Rows) error,
+) (err error) {
+	ctx = c.before(ctx, table, op)
+	defer func() { c.after(ctx, table, op, err) }()
+
+	var rows *sql.Rows
+	if tx, ok := TxFromCtx(ctx); ok {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = c.db.QueryContext(ctx, query, args...)
+	}
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := rows.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	for rows.Next() {
+		if err = scan(rows); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func (c conn) queryRow(
+	ctx context.Context,
+	table, op, query string,
+	args []any,
+	scan func(row *sql.Row) error,
+) (err error) {
+	ctx = c.before(ctx, table, op)
+	defer func() { c.after(ctx, table, op, err) }()
+
+	var row *sql.Row
+	if tx, ok := TxFromCtx(ctx); ok {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = c.db.QueryRowContext(ctx, query, args...)
+	}
+	return scan(row)
+}
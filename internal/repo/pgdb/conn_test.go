@@ -0,0 +1,67 @@
+package pgdb_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+)
+
+var errIterate = errors.New("connection reset while reading rows")
+
+// brokenRows is a database/sql connector whose selects fail while the rows
+// are read, after the query itself succeeded.
+type brokenRows struct {
+	closed *bool
+}
+
+func (c brokenRows) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c brokenRows) Driver() driver.Driver                        { return nil }
+
+func (c brokenRows) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	return c, nil
+}
+
+func (c brokenRows) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c brokenRows) Begin() (driver.Tx, error)           { return nil, errors.New("not supported") }
+func (c brokenRows) Columns() []string                   { return []string{"id"} }
+func (c brokenRows) Next([]driver.Value) error           { return errIterate }
+
+func (c brokenRows) Close() error {
+	*c.closed = true
+	return nil
+}
+
+type iterationHook struct {
+	closed      *bool
+	err         error
+	afterClosed bool
+}
+
+func (h *iterationHook) BeforeQuery(ctx context.Context, _, _ string) context.Context { return ctx }
+
+func (h *iterationHook) AfterQuery(_ context.Context, _, _ string, err error) {
+	h.err = err
+	h.afterClosed = *h.closed
+}
+
+func TestQueryHookCoversIteration(t *testing.T) {
+	closed := false
+	db := sql.OpenDB(brokenRows{closed: &closed})
+	defer db.Close()
+
+	hook := &iterationHook{closed: &closed}
+	if _, err := pgdb.NewWebhooksQ(db, hook).Select(context.Background()); !errors.Is(err, errIterate) {
+		t.Fatalf("select error = %v, want %v", err, errIterate)
+	}
+
+	if !errors.Is(hook.err, errIterate) {
+		t.Errorf("hook saw error %v, want %v", hook.err, errIterate)
+	}
+	if !hook.afterClosed {
+		t.Error("after hook ran before the rows were closed")
+	}
+}
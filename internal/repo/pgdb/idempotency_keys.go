@@ -0,0 +1,169 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const idempotencyKeysTable = "idempotency_keys"
+
+type IdempotencyKey struct {
+	UserID       uuid.UUID      `db:"user_id"`
+	Key          string         `db:"key"`
+	Fingerprint  string         `db:"fingerprint"`
+	StatusCode   sql.NullInt32  `db:"status_code"`
+	ContentType  sql.NullString `db:"content_type"`
+	ResponseBody []byte         `db:"response_body"`
+	LockedUntil  time.Time      `db:"locked_until"`
+	ExpiresAt    time.Time      `db:"expires_at"`
+	CreatedAt    time.Time      `db:"created_at"`
+}
+
+type IdempotencyKeysQ struct {
+	conn     conn
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewIdempotencyKeysQ(db *sql.DB, hooks ...QueryHook) IdempotencyKeysQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	cols := []string{
+		"user_id",
+		"key",
+		"fingerprint",
+		"status_code",
+		"content_type",
+		"response_body",
+		"locked_until",
+		"expires_at",
+		"created_at",
+	}
+	return IdempotencyKeysQ{
+		conn:     conn{db: db, hooks: hooks},
+		selector: b.Select(cols...).From(idempotencyKeysTable),
+		inserter: b.Insert(idempotencyKeysTable),
+		updater:  b.Update(idempotencyKeysTable),
+		deleter:  b.Delete(idempotencyKeysTable),
+	}
+}
+
+func (q IdempotencyKeysQ) New() IdempotencyKeysQ {
+	return NewIdempotencyKeysQ(q.conn.db, q.conn.hooks...)
+}
+
+// Insert adds the key unless the user already has it, created is false then.
+func (q IdempotencyKeysQ) Insert(ctx context.Context, in IdempotencyKey) (created bool, err error) {
+	values := map[string]interface{}{
+		"user_id":       in.UserID,
+		"key":           in.Key,
+		"fingerprint":   in.Fingerprint,
+		"status_code":   in.StatusCode,
+		"content_type":  in.ContentType,
+		"response_body": in.ResponseBody,
+		"locked_until":  in.LockedUntil,
+		"expires_at":    in.ExpiresAt,
+	}
+	if !in.CreatedAt.IsZero() {
+		values["created_at"] = in.CreatedAt
+	}
+
+	sqlStr, args, err := q.inserter.SetMap(values).Suffix("ON CONFLICT DO NOTHING RETURNING key").ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build insert %s: %w", idempotencyKeysTable, err)
+	}
+
+	var key string
+	err = q.conn.queryRow(ctx, idempotencyKeysTable, "insert", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(&key)
+	})
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (q IdempotencyKeysQ) Get(ctx context.Context) (IdempotencyKey, error) {
+	sqlStr, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return IdempotencyKey{}, fmt.Errorf("build select %s: %w", idempotencyKeysTable, err)
+	}
+
+	var m IdempotencyKey
+	err = q.conn.queryRow(ctx, idempotencyKeysTable, "get", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(
+			&m.UserID,
+			&m.Key,
+			&m.Fingerprint,
+			&m.StatusCode,
+			&m.ContentType,
+			&m.ResponseBody,
+			&m.LockedUntil,
+			&m.ExpiresAt,
+			&m.CreatedAt,
+		)
+	})
+	if err != nil {
+		return IdempotencyKey{}, err
+	}
+	return m, nil
+}
+
+func (q IdempotencyKeysQ) Update(ctx context.Context) error {
+	sqlStr, args, err := q.updater.ToSql()
+	if err != nil {
+		return fmt.Errorf("build update %s: %w", idempotencyKeysTable, err)
+	}
+
+	return q.conn.exec(ctx, idempotencyKeysTable, "update", sqlStr, args...)
+}
+
+func (q IdempotencyKeysQ) UpdateResponse(statusCode int, contentType string, body []byte) IdempotencyKeysQ {
+	q.updater = q.updater.
+		Set("status_code", statusCode).
+		Set("content_type", contentType).
+		Set("response_body", body)
+	return q
+}
+
+func (q IdempotencyKeysQ) Delete(ctx context.Context) error {
+	sqlStr, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", idempotencyKeysTable, err)
+	}
+
+	return q.conn.exec(ctx, idempotencyKeysTable, "delete", sqlStr, args...)
+}
+
+func (q IdempotencyKeysQ) FilterUserID(userID uuid.UUID) IdempotencyKeysQ {
+	q.selector = q.selector.Where(sq.Eq{"user_id": userID})
+	q.updater = q.updater.Where(sq.Eq{"user_id": userID})
+	q.deleter = q.deleter.Where(sq.Eq{"user_id": userID})
+	return q
+}
+
+func (q IdempotencyKeysQ) FilterKey(key string) IdempotencyKeysQ {
+	q.selector = q.selector.Where(sq.Eq{"key": key})
+	q.updater = q.updater.Where(sq.Eq{"key": key})
+	q.deleter = q.deleter.Where(sq.Eq{"key": key})
+	return q
+}
+
+// FilterExpiredAt matches the keys which expired at t.
+func (q IdempotencyKeysQ) FilterExpiredAt(t time.Time) IdempotencyKeysQ {
+	q.selector = q.selector.Where(sq.LtOrEq{"expires_at": t})
+	q.updater = q.updater.Where(sq.LtOrEq{"expires_at": t})
+	q.deleter = q.deleter.Where(sq.LtOrEq{"expires_at": t})
+	return q
+}
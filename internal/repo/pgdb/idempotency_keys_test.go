@@ -0,0 +1,109 @@
+package pgdb_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+)
+
+func insertIdempotencyKey(t *testing.T, ctx context.Context, db *sql.DB, userID uuid.UUID, key string, expiresAt time.Time) pgdb.IdempotencyKey {
+	t.Helper()
+
+	k := pgdb.IdempotencyKey{
+		UserID:      userID,
+		Key:         key,
+		Fingerprint: "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef",
+		LockedUntil: now().Add(time.Minute),
+		ExpiresAt:   expiresAt,
+		CreatedAt:   now(),
+	}
+	created, err := pgdb.NewIdempotencyKeysQ(db).Insert(ctx, k)
+	if err != nil || !created {
+		t.Fatalf("insert idempotency key %s: created %t, %v", key, created, err)
+	}
+
+	return k
+}
+
+func TestIdempotencyKeysInsertConflict(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertIdempotencyKey(t, ctx, db, uuid.New(), "k1", now().Add(time.Hour))
+
+	created, err := pgdb.NewIdempotencyKeysQ(db).Insert(ctx, k)
+	if err != nil || created {
+		t.Errorf("insert of an existing key: created %t, %v", created, err)
+	}
+
+	got, err := pgdb.NewIdempotencyKeysQ(db).FilterUserID(k.UserID).FilterKey(k.Key).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Fingerprint != k.Fingerprint || got.StatusCode.Valid || got.ResponseBody != nil || !got.ExpiresAt.Equal(k.ExpiresAt) {
+		t.Errorf("got %+v, want %+v", got, k)
+	}
+}
+
+func TestIdempotencyKeysUpdateResponse(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertIdempotencyKey(t, ctx, db, uuid.New(), "k1", now().Add(time.Hour))
+	other := insertIdempotencyKey(t, ctx, db, uuid.New(), "k1", now().Add(time.Hour))
+
+	err := pgdb.NewIdempotencyKeysQ(db).
+		FilterUserID(k.UserID).
+		FilterKey(k.Key).
+		UpdateResponse(201, "application/json", []byte(`{"data":{}}`)).
+		Update(ctx)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := pgdb.NewIdempotencyKeysQ(db).FilterUserID(k.UserID).FilterKey(k.Key).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.StatusCode.Int32 != 201 || got.ContentType.String != "application/json" || string(got.ResponseBody) != `{"data":{}}` {
+		t.Errorf("got %+v", got)
+	}
+
+	got, err = pgdb.NewIdempotencyKeysQ(db).FilterUserID(other.UserID).FilterKey(other.Key).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.StatusCode.Valid {
+		t.Errorf("key of another user was updated: %+v", got)
+	}
+}
+
+func TestIdempotencyKeysDeleteExpired(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	userID := uuid.New()
+	expired := insertIdempotencyKey(t, ctx, db, userID, "expired", now().Add(-time.Minute))
+	live := insertIdempotencyKey(t, ctx, db, userID, "live", now().Add(time.Hour))
+
+	if err := pgdb.NewIdempotencyKeysQ(db).FilterExpiredAt(now()).Delete(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	_, err := pgdb.NewIdempotencyKeysQ(db).FilterUserID(userID).FilterKey(expired.Key).Get(ctx)
+	if !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expired key: err = %v, want %v", err, sql.ErrNoRows)
+	}
+	if _, err = pgdb.NewIdempotencyKeysQ(db).FilterUserID(userID).FilterKey(live.Key).Get(ctx); err != nil {
+		t.Errorf("live key: %v", err)
+	}
+}
@@ -10,20 +10,21 @@ import (
 	"github.com/google/uuid"
 )
 
-const invitesTable = "invite"
+const invitesTable = "invites"
 
 type Invite struct {
-	ID        uuid.UUID `db:"id"`
-	UserID    uuid.UUID `db:"user_id"`
-	CityID    uuid.UUID `db:"city_id"`
-	Status    string    `db:"status"`
-	Role      string    `db:"role"`
-	ExpiresAt time.Time `db:"expires_at"`
-	CreatedAt time.Time `db:"created_at"`
+	ID          uuid.UUID `db:"id"`
+	UserID      uuid.UUID `db:"user_id"`
+	CityID      uuid.UUID `db:"city_id"`
+	InitiatorID uuid.UUID `db:"initiator_id"`
+	Status      string    `db:"status"`
+	Role        string    `db:"role"`
+	ExpiresAt   time.Time `db:"expires_at"`
+	CreatedAt   time.Time `db:"created_at"`
 }
 
 type InvitesQ struct {
-	db       *sql.DB
+	conn     conn
 	selector sq.SelectBuilder
 	inserter sq.InsertBuilder
 	updater  sq.UpdateBuilder
@@ -31,7 +32,7 @@ type InvitesQ struct {
 	counter  sq.SelectBuilder
 }
 
-func NewInvitesQ(db *sql.DB) InvitesQ {
+func NewInvitesQ(db *sql.DB, hooks ...QueryHook) InvitesQ {
 	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	cols := []string{
 		"id",
@@ -39,11 +40,12 @@ func NewInvitesQ(db *sql.DB) InvitesQ {
 		"role",
 		"city_id",
 		"user_id",
+		"initiator_id",
 		"expires_at",
 		"created_at",
 	}
 	return InvitesQ{
-		db:       db,
+		conn:     conn{db: db, hooks: hooks},
 		selector: b.Select(cols...).From(invitesTable),
 		inserter: b.Insert(invitesTable),
 		updater:  b.Update(invitesTable),
@@ -52,16 +54,17 @@ func NewInvitesQ(db *sql.DB) InvitesQ {
 	}
 }
 
-func (q InvitesQ) New() InvitesQ { return NewInvitesQ(q.db) }
+func (q InvitesQ) New() InvitesQ { return NewInvitesQ(q.conn.db, q.conn.hooks...) }
 
 func (q InvitesQ) Insert(ctx context.Context, in Invite) error {
 	values := map[string]interface{}{
-		"id":         in.ID,
-		"status":     in.Status,
-		"role":       in.Role,
-		"city_id":    in.CityID,
-		"user_id":    in.UserID,    // NOT NULL
-		"expires_at": in.ExpiresAt, // NOT NULL
+		"id":           in.ID,
+		"status":       in.Status,
+		"role":         in.Role,
+		"city_id":      in.CityID,
+		"user_id":      in.UserID,      // NOT NULL
+		"initiator_id": in.InitiatorID, // NOT NULL
+		"expires_at":   in.ExpiresAt,   // NOT NULL
 	}
 	if !in.CreatedAt.IsZero() {
 		values["created_at"] = in.CreatedAt
@@ -72,12 +75,7 @@ func (q InvitesQ) Insert(ctx context.Context, in Invite) error {
 		return fmt.Errorf("build insert %s: %w", invitesTable, err)
 	}
 
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, sqlStr, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, sqlStr, args...)
-	}
-	return err
+	return q.conn.exec(ctx, invitesTable, "insert", sqlStr, args...)
 }
 
 func (q InvitesQ) Get(ctx context.Context) (Invite, error) {
@@ -86,23 +84,20 @@ func (q InvitesQ) Get(ctx context.Context) (Invite, error) {
 		return Invite{}, fmt.Errorf("build select %s: %w", invitesTable, err)
 	}
 
-	var row *sql.Row
-	if tx, ok := TxFromCtx(ctx); ok {
-		row = tx.QueryRowContext(ctx, sqlStr, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, sqlStr, args...)
-	}
-
 	var m Invite
-	if err := row.Scan(
-		&m.ID,
-		&m.Status,
-		&m.Role,
-		&m.CityID,
-		&m.UserID,
-		&m.ExpiresAt,
-		&m.CreatedAt,
-	); err != nil {
+	err = q.conn.queryRow(ctx, invitesTable, "get", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(
+			&m.ID,
+			&m.Status,
+			&m.Role,
+			&m.CityID,
+			&m.UserID,
+			&m.InitiatorID,
+			&m.ExpiresAt,
+			&m.CreatedAt,
+		)
+	})
+	if err != nil {
 		return Invite{}, err
 	}
 	return m, nil
@@ -114,19 +109,8 @@ func (q InvitesQ) Select(ctx context.Context) ([]Invite, error) {
 		return nil, fmt.Errorf("build select %s: %w", invitesTable, err)
 	}
 
-	var rows *sql.Rows
-	if tx, ok := TxFromCtx(ctx); ok {
-		rows, err = tx.QueryContext(ctx, sqlStr, args...)
-	} else {
-		rows, err = q.db.QueryContext(ctx, sqlStr, args...)
-	}
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
 	var out []Invite
-	for rows.Next() {
+	err = q.conn.query(ctx, invitesTable, "select", sqlStr, args, func(rows *sql.Rows) error {
 		var m Invite
 		if err := rows.Scan(
 			&m.ID,
@@ -134,13 +118,19 @@ func (q InvitesQ) Select(ctx context.Context) ([]Invite, error) {
 			&m.Role,
 			&m.CityID,
 			&m.UserID,
+			&m.InitiatorID,
 			&m.ExpiresAt,
 			&m.CreatedAt,
 		); err != nil {
-			return nil, err
+			return err
 		}
 		out = append(out, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
+
 	return out, nil
 }
 
@@ -150,12 +140,17 @@ func (q InvitesQ) Update(ctx context.Context) error {
 		return fmt.Errorf("building update query for %s: %w", invitesTable, err)
 	}
 
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, query, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, query, args...)
+	return q.conn.exec(ctx, invitesTable, "update", query, args...)
+}
+
+// UpdateAffected is Update reporting how many rows matched the filters.
+func (q InvitesQ) UpdateAffected(ctx context.Context) (int64, error) {
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("building update query for %s: %w", invitesTable, err)
 	}
-	return err
+
+	return q.conn.execAffected(ctx, invitesTable, "update", query, args...)
 }
 
 func (q InvitesQ) UpdateStatus(status string) InvitesQ {
@@ -178,12 +173,7 @@ func (q InvitesQ) Delete(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("build delete %s: %w", invitesTable, err)
 	}
-	if tx, ok := TxFromCtx(ctx); ok {
-		_, err = tx.ExecContext(ctx, sqlStr, args...)
-	} else {
-		_, err = q.db.ExecContext(ctx, sqlStr, args...)
-	}
-	return err
+	return q.conn.exec(ctx, invitesTable, "delete", sqlStr, args...)
 }
 
 func (q InvitesQ) FilterID(id uuid.UUID) InvitesQ {
@@ -194,7 +184,7 @@ func (q InvitesQ) FilterID(id uuid.UUID) InvitesQ {
 	return q
 }
 
-func (q InvitesQ) FilterCityID(cityID uuid.UUID) InvitesQ {
+func (q InvitesQ) FilterCityID(cityID ...uuid.UUID) InvitesQ {
 	q.selector = q.selector.Where(sq.Eq{"city_id": cityID})
 	q.updater = q.updater.Where(sq.Eq{"city_id": cityID})
 	q.deleter = q.deleter.Where(sq.Eq{"city_id": cityID})
@@ -202,7 +192,7 @@ func (q InvitesQ) FilterCityID(cityID uuid.UUID) InvitesQ {
 	return q
 }
 
-func (q InvitesQ) FilterUserID(userID uuid.UUID) InvitesQ {
+func (q InvitesQ) FilterUserID(userID ...uuid.UUID) InvitesQ {
 	q.selector = q.selector.Where(sq.Eq{"user_id": userID})
 	q.updater = q.updater.Where(sq.Eq{"user_id": userID})
 	q.deleter = q.deleter.Where(sq.Eq{"user_id": userID})
@@ -287,13 +277,10 @@ func (q InvitesQ) Count(ctx context.Context) (uint64, error) {
 	}
 
 	var n uint64
-	var row *sql.Row
-	if tx, ok := TxFromCtx(ctx); ok {
-		row = tx.QueryRowContext(ctx, sqlStr, args...)
-	} else {
-		row = q.db.QueryRowContext(ctx, sqlStr, args...)
-	}
-	if err := row.Scan(&n); err != nil {
+	err = q.conn.queryRow(ctx, invitesTable, "count", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(&n)
+	})
+	if err != nil {
 		return 0, fmt.Errorf("scan count %s: %w", invitesTable, err)
 	}
 	return n, nil
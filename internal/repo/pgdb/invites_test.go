@@ -0,0 +1,225 @@
+package pgdb_test
+
+import (
+	"database/sql"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+)
+
+func inviteIDs(invites []pgdb.Invite) []uuid.UUID {
+	res := make([]uuid.UUID, len(invites))
+	for i, inv := range invites {
+		res[i] = inv.ID
+	}
+	return res
+}
+
+func TestInvitesInsertGet(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	want := insertInvite(t, ctx, db, c.ID, func(i *pgdb.Invite) { i.Role = "tech-lead" })
+
+	got, err := pgdb.NewInvitesQ(db).FilterID(want.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.ID != want.ID || got.UserID != want.UserID || got.CityID != want.CityID ||
+		got.InitiatorID != want.InitiatorID || got.Status != want.Status || got.Role != want.Role ||
+		!got.ExpiresAt.Equal(want.ExpiresAt) || !got.CreatedAt.Equal(want.CreatedAt) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	if _, err = pgdb.NewInvitesQ(db).FilterID(uuid.New()).Get(ctx); !errors.Is(err, sql.ErrNoRows) {
+		t.Errorf("expected %v for unknown invite, got %v", sql.ErrNoRows, err)
+	}
+}
+
+func TestInvitesFilters(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	k := insertCity(t, ctx, db, "Kyiv", kyiv)
+	l := insertCity(t, ctx, db, "Lviv", lviv)
+
+	base := now()
+	at := func(created, expires time.Duration) func(i *pgdb.Invite) {
+		return func(i *pgdb.Invite) {
+			i.CreatedAt = base.Add(created)
+			i.ExpiresAt = base.Add(expires)
+		}
+	}
+
+	user := uuid.New()
+	a := insertInvite(t, ctx, db, k.ID, at(0, 3*time.Hour), func(i *pgdb.Invite) { i.UserID = user })
+	b := insertInvite(t, ctx, db, k.ID, at(time.Minute, time.Hour), func(i *pgdb.Invite) {
+		i.Status = "accepted"
+		i.Role = "moderator"
+	})
+	c := insertInvite(t, ctx, db, l.ID, at(2*time.Minute, -time.Hour), func(i *pgdb.Invite) { i.UserID = user })
+
+	cases := []struct {
+		name  string
+		query func(q pgdb.InvitesQ) pgdb.InvitesQ
+		want  []uuid.UUID
+		total int
+	}{
+		{name: "id", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterID(b.ID) }, want: []uuid.UUID{b.ID}},
+		{name: "city", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterCityID(l.ID) }, want: []uuid.UUID{c.ID}},
+		{name: "user", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterUserID(user) }, want: []uuid.UUID{a.ID, c.ID}},
+		{name: "status", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterStatus("accepted") }, want: []uuid.UUID{b.ID}},
+		{name: "role", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterRole("member") }, want: []uuid.UUID{a.ID, c.ID}},
+		{name: "expires before", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterExpiresBefore(base.Add(time.Hour)) }, want: []uuid.UUID{b.ID, c.ID}},
+		{name: "expires after", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.FilterExpiresAfter(base) }, want: []uuid.UUID{a.ID, b.ID}},
+		{name: "created between", query: func(q pgdb.InvitesQ) pgdb.InvitesQ {
+			return q.FilterCreatedBetween(base.Add(time.Minute), base.Add(2*time.Minute))
+		}, want: []uuid.UUID{b.ID, c.ID}},
+		{name: "created desc", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.OrderByCreatedAt(false) }, want: []uuid.UUID{c.ID, b.ID, a.ID}},
+		{name: "expires", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.OrderByExpiresAt(true) }, want: []uuid.UUID{c.ID, b.ID, a.ID}},
+		{name: "page", query: func(q pgdb.InvitesQ) pgdb.InvitesQ { return q.OrderByExpiresAt(false).Page(1, 1) }, want: []uuid.UUID{b.ID}, total: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.query(pgdb.NewInvitesQ(db)).OrderByCreatedAt(true).Select(ctx)
+			if err != nil {
+				t.Fatalf("select: %v", err)
+			}
+			if ids := inviteIDs(got); !slices.Equal(ids, tc.want) {
+				t.Fatalf("got %v, want %v", ids, tc.want)
+			}
+
+			n, err := tc.query(pgdb.NewInvitesQ(db)).Count(ctx)
+			if err != nil {
+				t.Fatalf("count: %v", err)
+			}
+			total := tc.total
+			if total == 0 {
+				total = len(tc.want)
+			}
+			if n != uint64(total) {
+				t.Errorf("count = %d, want %d", n, total)
+			}
+		})
+	}
+}
+
+func TestInvitesUpdate(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	inv := insertInvite(t, ctx, db, c.ID)
+	other := insertInvite(t, ctx, db, c.ID)
+
+	user := uuid.New()
+	err := pgdb.NewInvitesQ(db).
+		FilterID(inv.ID).
+		UpdateStatus("revoked").
+		UpdateRole("moderator").
+		UpdateUserID(user).
+		Update(ctx)
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := pgdb.NewInvitesQ(db).FilterID(inv.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Status != "revoked" || got.Role != "moderator" || got.UserID != user {
+		t.Errorf("unexpected invite after update %+v", got)
+	}
+
+	untouched, err := pgdb.NewInvitesQ(db).FilterID(other.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get other: %v", err)
+	}
+	if untouched.Status != "sent" || untouched.Role != "member" {
+		t.Errorf("update leaked to another invite: %+v", untouched)
+	}
+}
+
+func TestInvitesUpdateAffected(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	inv := insertInvite(t, ctx, db, c.ID)
+
+	revoke := pgdb.NewInvitesQ(db).FilterID(inv.ID).FilterStatus("sent").UpdateStatus("revoked")
+
+	affected, err := revoke.UpdateAffected(ctx)
+	if err != nil || affected != 1 {
+		t.Fatalf("first update = %d, %v, want 1 row", affected, err)
+	}
+
+	affected, err = revoke.UpdateAffected(ctx)
+	if err != nil || affected != 0 {
+		t.Errorf("update of an already revoked invite = %d, %v, want 0 rows", affected, err)
+	}
+}
+
+func TestInvitesDelete(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	c := insertCity(t, ctx, db, "Kyiv", kyiv)
+	inv := insertInvite(t, ctx, db, c.ID)
+	insertInvite(t, ctx, db, c.ID)
+
+	if err := pgdb.NewInvitesQ(db).FilterID(inv.ID).Delete(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	n, err := pgdb.NewInvitesQ(db).FilterCityID(c.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if n != 1 {
+		t.Errorf("invites = %d, want 1", n)
+	}
+}
+
+func TestInvitesConstraints(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+
+	t.Run("suspended city", func(t *testing.T) {
+		ctx := pgtest.Tx(t)
+		c := insertCity(t, ctx, db, "Kyiv", kyiv, func(c *pgdb.City) { c.Status = "suspended" })
+
+		err := pgdb.NewInvitesQ(db).Insert(ctx, pgdb.Invite{
+			ID: uuid.New(), UserID: uuid.New(), CityID: c.ID, InitiatorID: uuid.New(),
+			Status: "sent", Role: "member", ExpiresAt: now().Add(time.Hour),
+		})
+		if err == nil {
+			t.Fatal("expected the trigger to reject the invite")
+		}
+	})
+
+	t.Run("unknown status", func(t *testing.T) {
+		ctx := pgtest.Tx(t)
+		c := insertCity(t, ctx, db, "Kyiv", kyiv)
+
+		err := pgdb.NewInvitesQ(db).Insert(ctx, pgdb.Invite{
+			ID: uuid.New(), UserID: uuid.New(), CityID: c.ID, InitiatorID: uuid.New(),
+			Status: "lost", Role: "member", ExpiresAt: now().Add(time.Hour),
+		})
+		if err == nil {
+			t.Fatal("expected the enum to reject the status")
+		}
+	})
+}
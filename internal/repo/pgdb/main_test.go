@@ -0,0 +1,100 @@
+package pgdb_test
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+func TestMain(m *testing.M) {
+	os.Exit(pgtest.Main(m))
+}
+
+// now is truncated to the precision of a postgres timestamp so that values
+// read back compare equal.
+func now() time.Time {
+	return time.Now().UTC().Truncate(time.Microsecond)
+}
+
+func insertCity(t *testing.T, ctx context.Context, db *sql.DB, name string, point orb.Point, modify ...func(c *pgdb.City)) pgdb.City {
+	t.Helper()
+
+	ts := now()
+	c := pgdb.City{
+		ID:        uuid.New(),
+		CountryID: "UKR",
+		Point:     point,
+		Status:    "supported",
+		Name:      name,
+		Timezone:  "Europe/Kyiv",
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	for _, m := range modify {
+		m(&c)
+	}
+
+	if err := pgdb.NewCitiesQ(db).Insert(ctx, c); err != nil {
+		t.Fatalf("insert city %s: %v", name, err)
+	}
+
+	return c
+}
+
+func insertAdmin(t *testing.T, ctx context.Context, db *sql.DB, cityID uuid.UUID, role string, modify ...func(a *pgdb.CityAdmin)) pgdb.CityAdmin {
+	t.Helper()
+
+	ts := now()
+	a := pgdb.CityAdmin{
+		UserID:    uuid.New(),
+		CityID:    cityID,
+		Role:      role,
+		CreatedAt: ts,
+		UpdatedAt: ts,
+	}
+	for _, m := range modify {
+		m(&a)
+	}
+
+	if err := pgdb.NewCityAdminsQ(db).Insert(ctx, a); err != nil {
+		t.Fatalf("insert %s: %v", role, err)
+	}
+
+	return a
+}
+
+func insertInvite(t *testing.T, ctx context.Context, db *sql.DB, cityID uuid.UUID, modify ...func(i *pgdb.Invite)) pgdb.Invite {
+	t.Helper()
+
+	ts := now()
+	inv := pgdb.Invite{
+		ID:          uuid.New(),
+		UserID:      uuid.New(),
+		CityID:      cityID,
+		InitiatorID: uuid.New(),
+		Status:      "sent",
+		Role:        "member",
+		ExpiresAt:   ts.Add(24 * time.Hour),
+		CreatedAt:   ts,
+	}
+	for _, m := range modify {
+		m(&inv)
+	}
+
+	if err := pgdb.NewInvitesQ(db).Insert(ctx, inv); err != nil {
+		t.Fatalf("insert invite: %v", err)
+	}
+
+	return inv
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
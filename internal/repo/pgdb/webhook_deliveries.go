@@ -0,0 +1,270 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+)
+
+const webhookDeliveriesTable = "webhook_deliveries"
+
+type WebhookDelivery struct {
+	ID            uuid.UUID      `db:"id"`
+	WebhookID     uuid.UUID      `db:"webhook_id"`
+	EventID       uuid.UUID      `db:"event_id"`
+	EventType     string         `db:"event_type"`
+	Payload       []byte         `db:"payload"`
+	Status        string         `db:"status"`
+	Attempts      int            `db:"attempts"`
+	ResponseCode  sql.NullInt32  `db:"response_code"`
+	LastError     sql.NullString `db:"last_error"`
+	RequestID     sql.NullString `db:"request_id"`
+	NextAttemptAt time.Time      `db:"next_attempt_at"`
+	UpdatedAt     time.Time      `db:"updated_at"`
+	CreatedAt     time.Time      `db:"created_at"`
+}
+
+var webhookDeliveriesCols = []string{
+	"id",
+	"webhook_id",
+	"event_id",
+	"event_type",
+	"payload",
+	"status",
+	"attempts",
+	"response_code",
+	"last_error",
+	"request_id",
+	"next_attempt_at",
+	"updated_at",
+	"created_at",
+}
+
+type WebhookDeliveriesQ struct {
+	conn     conn
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	updater  sq.UpdateBuilder
+	counter  sq.SelectBuilder
+}
+
+func NewWebhookDeliveriesQ(db *sql.DB, hooks ...QueryHook) WebhookDeliveriesQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	return WebhookDeliveriesQ{
+		conn:     conn{db: db, hooks: hooks},
+		selector: b.Select(webhookDeliveriesCols...).From(webhookDeliveriesTable),
+		inserter: b.Insert(webhookDeliveriesTable),
+		updater:  b.Update(webhookDeliveriesTable),
+		counter:  b.Select("COUNT(*) AS count").From(webhookDeliveriesTable),
+	}
+}
+
+func (q WebhookDeliveriesQ) New() WebhookDeliveriesQ {
+	return NewWebhookDeliveriesQ(q.conn.db, q.conn.hooks...)
+}
+
+func (q WebhookDeliveriesQ) Insert(ctx context.Context, in WebhookDelivery) error {
+	values := map[string]interface{}{
+		"id":              in.ID,
+		"webhook_id":      in.WebhookID,
+		"event_id":        in.EventID,
+		"event_type":      in.EventType,
+		"payload":         string(in.Payload), // jsonb, []byte would be sent as bytea
+		"status":          in.Status,
+		"attempts":        in.Attempts,
+		"response_code":   in.ResponseCode,
+		"last_error":      in.LastError,
+		"request_id":      in.RequestID,
+		"next_attempt_at": in.NextAttemptAt,
+	}
+	if !in.UpdatedAt.IsZero() {
+		values["updated_at"] = in.UpdatedAt
+	}
+	if !in.CreatedAt.IsZero() {
+		values["created_at"] = in.CreatedAt
+	}
+
+	sqlStr, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert %s: %w", webhookDeliveriesTable, err)
+	}
+
+	return q.conn.exec(ctx, webhookDeliveriesTable, "insert", sqlStr, args...)
+}
+
+func (q WebhookDeliveriesQ) Get(ctx context.Context) (WebhookDelivery, error) {
+	sqlStr, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return WebhookDelivery{}, fmt.Errorf("build select %s: %w", webhookDeliveriesTable, err)
+	}
+
+	var m WebhookDelivery
+	err = q.conn.queryRow(ctx, webhookDeliveriesTable, "get", sqlStr, args, func(row *sql.Row) error {
+		return scanWebhookDelivery(row, &m)
+	})
+	if err != nil {
+		return WebhookDelivery{}, err
+	}
+	return m, nil
+}
+
+func (q WebhookDeliveriesQ) Select(ctx context.Context) ([]WebhookDelivery, error) {
+	sqlStr, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", webhookDeliveriesTable, err)
+	}
+
+	return q.selectRows(ctx, "select", sqlStr, args...)
+}
+
+// Claim postpones up to limit pending deliveries which are due at now to
+// until and returns them. Rows locked by a concurrent claim are skipped, so
+// every delivery is handed to one caller only.
+func (q WebhookDeliveriesQ) Claim(ctx context.Context, now, until time.Time, limit uint64) ([]WebhookDelivery, error) {
+	due := sq.Select("id").
+		From(webhookDeliveriesTable).
+		Where(sq.Eq{"status": "pending"}).
+		Where(sq.LtOrEq{"next_attempt_at": now}).
+		OrderBy("next_attempt_at ASC").
+		Limit(limit).
+		Suffix("FOR UPDATE SKIP LOCKED")
+
+	sqlStr, args, err := q.updater.
+		Set("next_attempt_at", until).
+		Set("updated_at", now).
+		Where(sq.Expr("id IN (?)", due)).
+		Suffix("RETURNING " + strings.Join(webhookDeliveriesCols, ", ")).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build claim %s: %w", webhookDeliveriesTable, err)
+	}
+
+	return q.selectRows(ctx, "claim", sqlStr, args...)
+}
+
+func (q WebhookDeliveriesQ) selectRows(ctx context.Context, op, sqlStr string, args ...any) ([]WebhookDelivery, error) {
+	var out []WebhookDelivery
+	err := q.conn.query(ctx, webhookDeliveriesTable, op, sqlStr, args, func(rows *sql.Rows) error {
+		var m WebhookDelivery
+		if err := scanWebhookDelivery(rows, &m); err != nil {
+			return err
+		}
+		out = append(out, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func scanWebhookDelivery(row interface{ Scan(dest ...any) error }, m *WebhookDelivery) error {
+	return row.Scan(
+		&m.ID,
+		&m.WebhookID,
+		&m.EventID,
+		&m.EventType,
+		&m.Payload,
+		&m.Status,
+		&m.Attempts,
+		&m.ResponseCode,
+		&m.LastError,
+		&m.RequestID,
+		&m.NextAttemptAt,
+		&m.UpdatedAt,
+		&m.CreatedAt,
+	)
+}
+
+func (q WebhookDeliveriesQ) Update(ctx context.Context, updatedAt time.Time) error {
+	q.updater = q.updater.Set("updated_at", updatedAt)
+
+	query, args, err := q.updater.ToSql()
+	if err != nil {
+		return fmt.Errorf("building update query for %s: %w", webhookDeliveriesTable, err)
+	}
+
+	return q.conn.exec(ctx, webhookDeliveriesTable, "update", query, args...)
+}
+
+func (q WebhookDeliveriesQ) UpdateStatus(status string) WebhookDeliveriesQ {
+	q.updater = q.updater.Set("status", status)
+	return q
+}
+
+func (q WebhookDeliveriesQ) UpdateAttempts(attempts int) WebhookDeliveriesQ {
+	q.updater = q.updater.Set("attempts", attempts)
+	return q
+}
+
+func (q WebhookDeliveriesQ) UpdateResponseCode(code sql.NullInt32) WebhookDeliveriesQ {
+	q.updater = q.updater.Set("response_code", code)
+	return q
+}
+
+func (q WebhookDeliveriesQ) UpdateLastError(lastError sql.NullString) WebhookDeliveriesQ {
+	q.updater = q.updater.Set("last_error", lastError)
+	return q
+}
+
+func (q WebhookDeliveriesQ) UpdateNextAttemptAt(t time.Time) WebhookDeliveriesQ {
+	q.updater = q.updater.Set("next_attempt_at", t)
+	return q
+}
+
+func (q WebhookDeliveriesQ) FilterID(id uuid.UUID) WebhookDeliveriesQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.updater = q.updater.Where(sq.Eq{"id": id})
+	q.counter = q.counter.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q WebhookDeliveriesQ) FilterWebhookID(webhookID ...uuid.UUID) WebhookDeliveriesQ {
+	q.selector = q.selector.Where(sq.Eq{"webhook_id": webhookID})
+	q.updater = q.updater.Where(sq.Eq{"webhook_id": webhookID})
+	q.counter = q.counter.Where(sq.Eq{"webhook_id": webhookID})
+	return q
+}
+
+func (q WebhookDeliveriesQ) FilterStatus(status ...string) WebhookDeliveriesQ {
+	q.selector = q.selector.Where(sq.Eq{"status": status})
+	q.updater = q.updater.Where(sq.Eq{"status": status})
+	q.counter = q.counter.Where(sq.Eq{"status": status})
+	return q
+}
+
+func (q WebhookDeliveriesQ) OrderByCreatedAt(asc bool) WebhookDeliveriesQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+	q.selector = q.selector.OrderBy("created_at "+dir, "id "+dir)
+	return q
+}
+
+func (q WebhookDeliveriesQ) Count(ctx context.Context) (uint64, error) {
+	sqlStr, args, err := q.counter.ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build count %s: %w", webhookDeliveriesTable, err)
+	}
+
+	var n uint64
+	err = q.conn.queryRow(ctx, webhookDeliveriesTable, "count", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(&n)
+	})
+	if err != nil {
+		return 0, fmt.Errorf("scan count %s: %w", webhookDeliveriesTable, err)
+	}
+	return n, nil
+}
+
+func (q WebhookDeliveriesQ) Page(limit, offset uint64) WebhookDeliveriesQ {
+	q.selector = q.selector.Limit(limit).Offset(offset)
+	return q
+}
@@ -0,0 +1,145 @@
+package pgdb
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+)
+
+const webhooksTable = "webhooks"
+
+type Webhook struct {
+	ID         uuid.UUID `db:"id"`
+	URL        string    `db:"url"`
+	Secret     string    `db:"secret"`
+	EventTypes []string  `db:"event_types"`
+	CreatedAt  time.Time `db:"created_at"`
+}
+
+type WebhooksQ struct {
+	conn     conn
+	selector sq.SelectBuilder
+	inserter sq.InsertBuilder
+	deleter  sq.DeleteBuilder
+}
+
+func NewWebhooksQ(db *sql.DB, hooks ...QueryHook) WebhooksQ {
+	b := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+	cols := []string{
+		"id",
+		"url",
+		"secret",
+		"event_types",
+		"created_at",
+	}
+	return WebhooksQ{
+		conn:     conn{db: db, hooks: hooks},
+		selector: b.Select(cols...).From(webhooksTable),
+		inserter: b.Insert(webhooksTable),
+		deleter:  b.Delete(webhooksTable),
+	}
+}
+
+func (q WebhooksQ) New() WebhooksQ { return NewWebhooksQ(q.conn.db, q.conn.hooks...) }
+
+func (q WebhooksQ) Insert(ctx context.Context, in Webhook) error {
+	eventTypes := in.EventTypes
+	if eventTypes == nil {
+		eventTypes = []string{}
+	}
+
+	values := map[string]interface{}{
+		"id":          in.ID,
+		"url":         in.URL,
+		"secret":      in.Secret,
+		"event_types": pq.Array(eventTypes),
+	}
+	if !in.CreatedAt.IsZero() {
+		values["created_at"] = in.CreatedAt
+	}
+
+	sqlStr, args, err := q.inserter.SetMap(values).ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert %s: %w", webhooksTable, err)
+	}
+
+	return q.conn.exec(ctx, webhooksTable, "insert", sqlStr, args...)
+}
+
+func (q WebhooksQ) Get(ctx context.Context) (Webhook, error) {
+	sqlStr, args, err := q.selector.Limit(1).ToSql()
+	if err != nil {
+		return Webhook{}, fmt.Errorf("build select %s: %w", webhooksTable, err)
+	}
+
+	var m Webhook
+	err = q.conn.queryRow(ctx, webhooksTable, "get", sqlStr, args, func(row *sql.Row) error {
+		return row.Scan(
+			&m.ID,
+			&m.URL,
+			&m.Secret,
+			pq.Array(&m.EventTypes),
+			&m.CreatedAt,
+		)
+	})
+	if err != nil {
+		return Webhook{}, err
+	}
+	return m, nil
+}
+
+func (q WebhooksQ) Select(ctx context.Context) ([]Webhook, error) {
+	sqlStr, args, err := q.selector.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select %s: %w", webhooksTable, err)
+	}
+
+	var out []Webhook
+	err = q.conn.query(ctx, webhooksTable, "select", sqlStr, args, func(rows *sql.Rows) error {
+		var m Webhook
+		if err := rows.Scan(
+			&m.ID,
+			&m.URL,
+			&m.Secret,
+			pq.Array(&m.EventTypes),
+			&m.CreatedAt,
+		); err != nil {
+			return err
+		}
+		out = append(out, m)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+func (q WebhooksQ) Delete(ctx context.Context) error {
+	sqlStr, args, err := q.deleter.ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete %s: %w", webhooksTable, err)
+	}
+	return q.conn.exec(ctx, webhooksTable, "delete", sqlStr, args...)
+}
+
+func (q WebhooksQ) FilterID(id uuid.UUID) WebhooksQ {
+	q.selector = q.selector.Where(sq.Eq{"id": id})
+	q.deleter = q.deleter.Where(sq.Eq{"id": id})
+	return q
+}
+
+func (q WebhooksQ) OrderByCreatedAt(asc bool) WebhooksQ {
+	dir := "ASC"
+	if !asc {
+		dir = "DESC"
+	}
+	q.selector = q.selector.OrderBy("created_at " + dir)
+	return q
+}
@@ -0,0 +1,178 @@
+package pgdb_test
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/cities-svc/test/pgtest"
+	"github.com/google/uuid"
+)
+
+func insertWebhook(t *testing.T, ctx context.Context, db *sql.DB, eventTypes ...string) pgdb.Webhook {
+	t.Helper()
+
+	w := pgdb.Webhook{
+		ID:         uuid.New(),
+		URL:        "https://partner.example/hooks",
+		Secret:     "s3cret",
+		EventTypes: eventTypes,
+		CreatedAt:  now(),
+	}
+	if err := pgdb.NewWebhooksQ(db).Insert(ctx, w); err != nil {
+		t.Fatalf("insert webhook: %v", err)
+	}
+
+	return w
+}
+
+func insertDelivery(t *testing.T, ctx context.Context, db *sql.DB, webhookID uuid.UUID, modify ...func(d *pgdb.WebhookDelivery)) pgdb.WebhookDelivery {
+	t.Helper()
+
+	ts := now()
+	d := pgdb.WebhookDelivery{
+		ID:            uuid.New(),
+		WebhookID:     webhookID,
+		EventID:       uuid.New(),
+		EventType:     "city.created",
+		Payload:       []byte(`{"events": "city.created"}`),
+		Status:        "pending",
+		NextAttemptAt: ts,
+		UpdatedAt:     ts,
+		CreatedAt:     ts,
+	}
+	for _, m := range modify {
+		m(&d)
+	}
+
+	if err := pgdb.NewWebhookDeliveriesQ(db).Insert(ctx, d); err != nil {
+		t.Fatalf("insert delivery: %v", err)
+	}
+
+	return d
+}
+
+func TestWebhooksInsertGet(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	want := insertWebhook(t, ctx, db, "city.created", "city.invite.accepted")
+	all := insertWebhook(t, ctx, db)
+
+	got, err := pgdb.NewWebhooksQ(db).FilterID(want.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.URL != want.URL || got.Secret != want.Secret || !slices.Equal(got.EventTypes, want.EventTypes) ||
+		!got.CreatedAt.Equal(want.CreatedAt) {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+
+	got, err = pgdb.NewWebhooksQ(db).FilterID(all.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if len(got.EventTypes) != 0 {
+		t.Errorf("webhook without event types got %v", got.EventTypes)
+	}
+}
+
+func TestWebhookDeliveriesCascade(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	w := insertWebhook(t, ctx, db)
+	insertDelivery(t, ctx, db, w.ID)
+
+	if err := pgdb.NewWebhooksQ(db).FilterID(w.ID).Delete(ctx); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+
+	n, err := pgdb.NewWebhookDeliveriesQ(db).FilterWebhookID(w.ID).Count(ctx)
+	if err != nil {
+		t.Fatalf("count: %v", err)
+	}
+	if n != 0 {
+		t.Errorf("%d deliveries left after deleting the webhook", n)
+	}
+}
+
+func TestWebhookDeliveriesClaim(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	ts := now()
+	w := insertWebhook(t, ctx, db)
+	older := insertDelivery(t, ctx, db, w.ID, func(d *pgdb.WebhookDelivery) { d.NextAttemptAt = ts.Add(-2 * time.Minute) })
+	due := insertDelivery(t, ctx, db, w.ID, func(d *pgdb.WebhookDelivery) { d.NextAttemptAt = ts.Add(-time.Minute) })
+	insertDelivery(t, ctx, db, w.ID, func(d *pgdb.WebhookDelivery) { d.NextAttemptAt = ts.Add(time.Minute) })
+	insertDelivery(t, ctx, db, w.ID, func(d *pgdb.WebhookDelivery) {
+		d.Status = "dead"
+		d.NextAttemptAt = ts.Add(-time.Hour)
+	})
+
+	until := ts.Add(time.Minute)
+	got, err := pgdb.NewWebhookDeliveriesQ(db).Claim(ctx, ts, until, 1)
+	if err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != older.ID || !got[0].NextAttemptAt.Equal(until) {
+		t.Fatalf("claimed %+v, want the oldest due delivery leased until %s", got, until)
+	}
+	if string(got[0].Payload) != `{"events": "city.created"}` {
+		t.Errorf("payload = %s", got[0].Payload)
+	}
+
+	got, err = pgdb.NewWebhookDeliveriesQ(db).Claim(ctx, ts, until, 10)
+	if err != nil {
+		t.Fatalf("claim: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != due.ID {
+		t.Errorf("second claim got %+v, want only %s", got, due.ID)
+	}
+}
+
+func TestWebhookDeliveriesUpdate(t *testing.T) {
+	t.Parallel()
+	db := pgtest.DB(t)
+	ctx := pgtest.Tx(t)
+
+	w := insertWebhook(t, ctx, db)
+	d := insertDelivery(t, ctx, db, w.ID)
+
+	next := now().Add(time.Minute)
+	err := pgdb.NewWebhookDeliveriesQ(db).
+		FilterID(d.ID).
+		UpdateStatus("pending").
+		UpdateAttempts(1).
+		UpdateResponseCode(sql.NullInt32{Int32: 503, Valid: true}).
+		UpdateLastError(sql.NullString{String: "endpoint responded 503", Valid: true}).
+		UpdateNextAttemptAt(next).
+		Update(ctx, now())
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := pgdb.NewWebhookDeliveriesQ(db).FilterID(d.ID).Get(ctx)
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	if got.Attempts != 1 || got.ResponseCode.Int32 != 503 || got.LastError.String != "endpoint responded 503" ||
+		!got.NextAttemptAt.Equal(next) {
+		t.Errorf("got %+v", got)
+	}
+
+	res, err := pgdb.NewWebhookDeliveriesQ(db).FilterWebhookID(w.ID).FilterStatus("dead").Select(ctx)
+	if err != nil {
+		t.Fatalf("select: %v", err)
+	}
+	if len(res) != 0 {
+		t.Errorf("status filter returned %+v", res)
+	}
+}
@@ -3,12 +3,15 @@ package repo
 import (
 	"context"
 	"database/sql"
+	"sync/atomic"
 
 	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
 )
 
 type Repo struct {
-	sql SqlDB
+	sql      SqlDB
+	replicas []SqlDB
+	next     atomic.Uint64
 }
 
 func (r *Repo) Transaction(ctx context.Context, fn func(ctx context.Context) error) error {
@@ -16,17 +19,53 @@ func (r *Repo) Transaction(ctx context.Context, fn func(ctx context.Context) err
 }
 
 type SqlDB struct {
-	cities    pgdb.CitiesQ
-	invites   pgdb.InvitesQ
-	cityAdmin pgdb.CityAdminsQ
+	cities            pgdb.CitiesQ
+	invites           pgdb.InvitesQ
+	cityAdmin         pgdb.CityAdminsQ
+	webhooks          pgdb.WebhooksQ
+	webhookDeliveries pgdb.WebhookDeliveriesQ
+	idempotencyKeys   pgdb.IdempotencyKeysQ
 }
 
-func NewDatabase(db *sql.DB) *Repo {
-	return &Repo{
-		sql: SqlDB{
-			cities:    pgdb.NewCitiesQ(db),
-			invites:   pgdb.NewInvitesQ(db),
-			cityAdmin: pgdb.NewCityAdminsQ(db),
-		},
+func newSqlDB(db *sql.DB, hooks ...pgdb.QueryHook) SqlDB {
+	return SqlDB{
+		cities:            pgdb.NewCitiesQ(db, hooks...),
+		invites:           pgdb.NewInvitesQ(db, hooks...),
+		cityAdmin:         pgdb.NewCityAdminsQ(db, hooks...),
+		webhooks:          pgdb.NewWebhooksQ(db, hooks...),
+		webhookDeliveries: pgdb.NewWebhookDeliveriesQ(db, hooks...),
+		idempotencyKeys:   pgdb.NewIdempotencyKeysQ(db, hooks...),
 	}
 }
+
+func NewDatabase(db *sql.DB, hooks ...pgdb.QueryHook) *Repo {
+	return NewDatabaseWithReplicas(db, nil, hooks...)
+}
+
+// NewDatabaseWithReplicas builds a repo which sends read-only queries made
+// outside of a transaction to the replicas in round-robin order.
+func NewDatabaseWithReplicas(primary *sql.DB, replicas []*sql.DB, hooks ...pgdb.QueryHook) *Repo {
+	r := &Repo{
+		sql:      newSqlDB(primary, hooks...),
+		replicas: make([]SqlDB, 0, len(replicas)),
+	}
+	for _, db := range replicas {
+		r.replicas = append(r.replicas, newSqlDB(db, hooks...))
+	}
+
+	return r
+}
+
+// read picks the connection for a read-only query, reads inside a transaction
+// stay on the primary so that they see the transaction's own writes. Reads
+// which authorize an action must not use it, they go to the primary too.
+func (r *Repo) read(ctx context.Context) SqlDB {
+	if len(r.replicas) == 0 {
+		return r.sql
+	}
+	if _, ok := pgdb.TxFromCtx(ctx); ok {
+		return r.sql
+	}
+
+	return r.replicas[(r.next.Add(1)-1)%uint64(len(r.replicas))]
+}
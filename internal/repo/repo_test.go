@@ -0,0 +1,127 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+var errRecorded = errors.New("query recorded")
+
+// recorder is a database/sql connector which notes the name of the database
+// every statement is sent to and fails it.
+type recorder struct {
+	name string
+	mu   *sync.Mutex
+	log  *[]string
+}
+
+func (c recorder) Connect(context.Context) (driver.Conn, error) { return recorderConn(c), nil }
+func (c recorder) Driver() driver.Driver                        { return nil }
+
+type recorderConn recorder
+
+func (c recorderConn) record() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*c.log = append(*c.log, c.name)
+}
+
+func (c recorderConn) QueryContext(context.Context, string, []driver.NamedValue) (driver.Rows, error) {
+	c.record()
+	return nil, errRecorded
+}
+
+func (c recorderConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	c.record()
+	return nil, errRecorded
+}
+
+func (c recorderConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (c recorderConn) Close() error                        { return nil }
+func (c recorderConn) Begin() (driver.Tx, error)           { return recorderTx{}, nil }
+
+type recorderTx struct{}
+
+func (recorderTx) Commit() error   { return nil }
+func (recorderTx) Rollback() error { return nil }
+
+func newRecordingRepo(t *testing.T) (*Repo, func() []string) {
+	t.Helper()
+
+	var (
+		mu  sync.Mutex
+		log []string
+	)
+	open := func(name string) *sql.DB {
+		db := sql.OpenDB(recorder{name: name, mu: &mu, log: &log})
+		t.Cleanup(func() { _ = db.Close() })
+		return db
+	}
+
+	r := NewDatabaseWithReplicas(open("primary"), []*sql.DB{open("replica-1"), open("replica-2")})
+
+	return r, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+
+		res := slices.Clone(log)
+		log = log[:0]
+		return res
+	}
+}
+
+func TestReadRouting(t *testing.T) {
+	r, queried := newRecordingRepo(t)
+	ctx := context.Background()
+
+	for range 4 {
+		_, _ = r.GetCityByID(ctx, uuid.New())
+	}
+	if got, want := queried(), []string{"replica-1", "replica-2", "replica-1", "replica-2"}; !slices.Equal(got, want) {
+		t.Errorf("reads went to %v, want %v", got, want)
+	}
+
+	_ = r.Transaction(ctx, func(ctx context.Context) error {
+		_, _ = r.GetCityByID(ctx, uuid.New())
+		_, _ = r.GetCityAdmins(ctx, uuid.New())
+		return nil
+	})
+	if got, want := queried(), []string{"primary", "primary"}; !slices.Equal(got, want) {
+		t.Errorf("reads in a transaction went to %v, want %v", got, want)
+	}
+
+	_, _ = r.GetCityAdmin(ctx, uuid.New(), uuid.New())
+	_, _ = r.GetInvite(ctx, uuid.New())
+	if got, want := queried(), []string{"primary", "primary"}; !slices.Equal(got, want) {
+		t.Errorf("authorization reads went to %v, want %v", got, want)
+	}
+
+	_ = r.UpdateInviteStatus(ctx, uuid.New(), "revoked")
+	if got, want := queried(), []string{"primary"}; !slices.Equal(got, want) {
+		t.Errorf("write went to %v, want %v", got, want)
+	}
+}
+
+func TestReadWithoutReplicas(t *testing.T) {
+	var (
+		mu  sync.Mutex
+		log []string
+	)
+	db := sql.OpenDB(recorder{name: "primary", mu: &mu, log: &log})
+	defer db.Close()
+
+	r := NewDatabase(db)
+	_, _ = r.GetCityByID(context.Background(), uuid.New())
+	_, _ = r.GetCityBySlug(context.Background(), "kyiv")
+
+	if want := []string{"primary", "primary"}; !slices.Equal(log, want) {
+		t.Errorf("reads went to %v, want %v", log, want)
+	}
+}
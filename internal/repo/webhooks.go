@@ -0,0 +1,214 @@
+package repo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	"github.com/chains-lab/restkit/pagi"
+	"github.com/google/uuid"
+)
+
+func (r *Repo) CreateWebhook(ctx context.Context, input models.Webhook) error {
+	return r.sql.webhooks.New().Insert(ctx, pgdb.Webhook{
+		ID:         input.ID,
+		URL:        input.URL,
+		Secret:     input.Secret,
+		EventTypes: input.EventTypes,
+		CreatedAt:  input.CreatedAt,
+	})
+}
+
+func (r *Repo) GetWebhook(ctx context.Context, ID uuid.UUID) (models.Webhook, error) {
+	row, err := r.read(ctx).webhooks.New().FilterID(ID).Get(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return models.Webhook{}, nil
+	case err != nil:
+		return models.Webhook{}, err
+	}
+
+	return webhookSchemaToModel(row), nil
+}
+
+func (r *Repo) GetWebhooks(ctx context.Context) ([]models.Webhook, error) {
+	rows, err := r.read(ctx).webhooks.New().OrderByCreatedAt(true).Select(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.Webhook, len(rows))
+	for i, row := range rows {
+		res[i] = webhookSchemaToModel(row)
+	}
+
+	return res, nil
+}
+
+func (r *Repo) DeleteWebhook(ctx context.Context, ID uuid.UUID) error {
+	return r.sql.webhooks.New().FilterID(ID).Delete(ctx)
+}
+
+func (r *Repo) CreateWebhookDelivery(ctx context.Context, input models.WebhookDelivery) error {
+	schema := pgdb.WebhookDelivery{
+		ID:            input.ID,
+		WebhookID:     input.WebhookID,
+		EventID:       input.EventID,
+		EventType:     input.EventType,
+		Payload:       input.Payload,
+		Status:        input.Status,
+		Attempts:      input.Attempts,
+		NextAttemptAt: input.NextAttemptAt,
+		UpdatedAt:     input.UpdatedAt,
+		CreatedAt:     input.CreatedAt,
+	}
+	if input.ResponseCode != nil {
+		schema.ResponseCode = sql.NullInt32{Int32: int32(*input.ResponseCode), Valid: true}
+	}
+	if input.LastError != nil {
+		schema.LastError = sql.NullString{String: *input.LastError, Valid: true}
+	}
+	if input.RequestID != "" {
+		schema.RequestID = sql.NullString{String: input.RequestID, Valid: true}
+	}
+
+	return r.sql.webhookDeliveries.New().Insert(ctx, schema)
+}
+
+func (r *Repo) GetWebhookDelivery(ctx context.Context, ID uuid.UUID) (models.WebhookDelivery, error) {
+	row, err := r.read(ctx).webhookDeliveries.New().FilterID(ID).Get(ctx)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return models.WebhookDelivery{}, nil
+	case err != nil:
+		return models.WebhookDelivery{}, err
+	}
+
+	return webhookDeliverySchemaToModel(row), nil
+}
+
+func (r *Repo) FilterWebhookDeliveries(
+	ctx context.Context,
+	filter webhook.FilterDeliveriesParams,
+	page, size uint64,
+) (models.WebhookDeliveriesCollection, error) {
+	limit, offset := pagi.PagConvert(page, size)
+
+	query := r.read(ctx).webhookDeliveries.New()
+
+	if filter.WebhookID != nil {
+		query = query.FilterWebhookID(filter.WebhookID...)
+	}
+	if filter.Status != nil {
+		query = query.FilterStatus(filter.Status...)
+	}
+
+	total, err := query.Count(ctx)
+	if err != nil {
+		return models.WebhookDeliveriesCollection{}, err
+	}
+
+	rows, err := query.OrderByCreatedAt(false).Page(limit, offset).Select(ctx)
+	if err != nil {
+		return models.WebhookDeliveriesCollection{}, err
+	}
+
+	res := make([]models.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		res[i] = webhookDeliverySchemaToModel(row)
+	}
+
+	return models.WebhookDeliveriesCollection{
+		Data:  res,
+		Page:  page,
+		Size:  size,
+		Total: total,
+	}, nil
+}
+
+func (r *Repo) ClaimWebhookDeliveries(
+	ctx context.Context,
+	now, until time.Time,
+	limit uint64,
+) ([]models.WebhookDelivery, error) {
+	rows, err := r.sql.webhookDeliveries.New().Claim(ctx, now, until, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]models.WebhookDelivery, len(rows))
+	for i, row := range rows {
+		res[i] = webhookDeliverySchemaToModel(row)
+	}
+
+	return res, nil
+}
+
+func (r *Repo) UpdateWebhookDelivery(
+	ctx context.Context,
+	ID uuid.UUID,
+	params webhook.UpdateDeliveryParams,
+	updatedAt time.Time,
+) error {
+	q := r.sql.webhookDeliveries.New().
+		FilterID(ID).
+		UpdateStatus(params.Status).
+		UpdateAttempts(params.Attempts).
+		UpdateNextAttemptAt(params.NextAttemptAt)
+
+	switch params.ResponseCode {
+	case nil:
+		q = q.UpdateResponseCode(sql.NullInt32{})
+	default:
+		q = q.UpdateResponseCode(sql.NullInt32{Int32: int32(*params.ResponseCode), Valid: true})
+	}
+	switch params.LastError {
+	case nil:
+		q = q.UpdateLastError(sql.NullString{})
+	default:
+		q = q.UpdateLastError(sql.NullString{String: *params.LastError, Valid: true})
+	}
+
+	return q.Update(ctx, updatedAt)
+}
+
+func webhookSchemaToModel(s pgdb.Webhook) models.Webhook {
+	return models.Webhook{
+		ID:         s.ID,
+		URL:        s.URL,
+		Secret:     s.Secret,
+		EventTypes: s.EventTypes,
+		CreatedAt:  s.CreatedAt,
+	}
+}
+
+func webhookDeliverySchemaToModel(s pgdb.WebhookDelivery) models.WebhookDelivery {
+	res := models.WebhookDelivery{
+		ID:            s.ID,
+		WebhookID:     s.WebhookID,
+		EventID:       s.EventID,
+		EventType:     s.EventType,
+		Payload:       s.Payload,
+		Status:        s.Status,
+		Attempts:      s.Attempts,
+		NextAttemptAt: s.NextAttemptAt,
+		UpdatedAt:     s.UpdatedAt,
+		CreatedAt:     s.CreatedAt,
+	}
+	if s.ResponseCode.Valid {
+		code := int(s.ResponseCode.Int32)
+		res.ResponseCode = &code
+	}
+	if s.LastError.Valid {
+		res.LastError = &s.LastError.String
+	}
+	if s.RequestID.Valid {
+		res.RequestID = s.RequestID.String
+	}
+
+	return res
+}
@@ -0,0 +1,61 @@
+// Package requestid carries the id of the request being handled through the
+// context, so that logs and published events can be correlated with it.
+package requestid
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Header is the HTTP header the id is read from and returned in.
+const Header = "X-Request-ID"
+
+// MaxLength is the longest id accepted from clients.
+const MaxLength = 128
+
+type ctxKey struct{}
+
+// New generates a request id.
+func New() string {
+	return uuid.NewString()
+}
+
+// Valid reports whether an id sent by a client can be used as is, it must be
+// printable ASCII without spaces so that it is safe to log and forward.
+func Valid(id string) bool {
+	if id == "" || len(id) > MaxLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func With(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// From returns the request id of ctx, empty outside of a request.
+func From(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// LogField is the log entry field the id is written to.
+const LogField = "request_id"
+
+// Log returns log with the request id of ctx attached. Everything logging on
+// behalf of a request, REST, gRPC or a webhook delivery, goes through it.
+func Log(ctx context.Context, log logrus.FieldLogger) logrus.FieldLogger {
+	if id := From(ctx); id != "" {
+		return log.WithField(LogField, id)
+	}
+
+	return log
+}
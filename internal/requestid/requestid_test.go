@@ -0,0 +1,55 @@
+package requestid
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+)
+
+func TestValid(t *testing.T) {
+	for id, want := range map[string]bool{
+		"":                                     false,
+		"3f2c1a9e-6a0b-4d7e-9c1f-2b8a4e6d0c11": true,
+		"req_01HZX5":                           true,
+		"with space":                           false,
+		"line\nbreak":                          false,
+		"ünïcode":                              false,
+		strings.Repeat("a", MaxLength):         true,
+		strings.Repeat("a", MaxLength+1):       false,
+	} {
+		if got := Valid(id); got != want {
+			t.Errorf("Valid(%q) = %t, want %t", id, got, want)
+		}
+	}
+
+	if id := New(); !Valid(id) {
+		t.Errorf("generated id %q is not valid", id)
+	}
+}
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if id := From(ctx); id != "" {
+		t.Errorf("From without id = %q", id)
+	}
+
+	if id := From(With(ctx, "req-1")); id != "req-1" {
+		t.Errorf("From = %q, want req-1", id)
+	}
+}
+
+func TestLog(t *testing.T) {
+	log, hook := test.NewNullLogger()
+
+	Log(context.Background(), log).Info("outside a request")
+	if _, ok := hook.LastEntry().Data[LogField]; ok {
+		t.Errorf("entry outside a request has %s", LogField)
+	}
+
+	Log(With(context.Background(), "req-1"), log).WithField("city_id", "kyiv").Info("inside a request")
+	if got := hook.LastEntry().Data[LogField]; got != "req-1" {
+		t.Errorf("%s = %v, want req-1", LogField, got)
+	}
+}
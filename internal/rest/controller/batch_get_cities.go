@@ -0,0 +1,28 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/rest/requests"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+)
+
+func (s Service) BatchGetCities(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.BatchGetCities(r)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to parse batch get cities request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	cities, notFound, err := s.domain.city.GetByIDs(r.Context(), req.Data.Attributes.Ids)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to batch get cities")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.CitiesBatch(cities, notFound))
+}
@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/rest/requests"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) BatchGetCityAdmins(w http.ResponseWriter, r *http.Request) {
+	req, err := requests.BatchGetCityAdmins(r)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to parse batch get city admins request")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	keys := make([]admin.Key, 0, len(req.Data.Attributes.Ids))
+	for i, id := range req.Data.Attributes.Ids {
+		userID, cityID, _ := strings.Cut(id, ":")
+
+		var key admin.Key
+		if key.UserID, err = uuid.Parse(userID); err == nil {
+			key.CityID, err = uuid.Parse(cityID)
+		}
+		if err != nil {
+			s.log(r.Context()).WithError(err).Error("invalid city admin id")
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				fmt.Sprintf("data/attributes/ids/%d", i): fmt.Errorf("invalid id: %s, need format user_id:city_id", id),
+			})...)
+			return
+		}
+
+		keys = append(keys, key)
+	}
+
+	admins, notFound, err := s.domain.admin.GetByKeys(r.Context(), keys)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to batch get city admins")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.CityAdminsBatch(admins, notFound))
+}
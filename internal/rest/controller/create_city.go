@@ -18,7 +18,7 @@ import (
 func (s Service) CreateCity(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -26,7 +26,7 @@ func (s Service) CreateCity(w http.ResponseWriter, r *http.Request) {
 
 	req, err := requests.CreateCity(r)
 	if err != nil {
-		s.log.WithError(err).Error("error creating city")
+		s.log(r.Context()).WithError(err).Error("error creating city")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
@@ -43,7 +43,7 @@ func (s Service) CreateCity(w http.ResponseWriter, r *http.Request) {
 		Timezone: req.Data.Attributes.Timezone,
 	})
 	if err != nil {
-		s.log.WithError(err).Error("error creating city")
+		s.log(r.Context()).WithError(err).Error("error creating city")
 		switch {
 		case errors.Is(err, errx.ErrorInvalidTimeZone):
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
@@ -73,7 +73,7 @@ func (s Service) CreateCity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.log.Infof("created city with name %s by user %s", c.Name, initiator.ID)
+	s.log(r.Context()).Infof("created city with name %s by user %s", c.Name, initiator.ID)
 
 	ape.Render(w, http.StatusCreated, responses.City(c))
 }
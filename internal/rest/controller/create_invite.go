@@ -10,6 +10,7 @@ import (
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/meta"
 	"github.com/chains-lab/cities-svc/internal/rest/requests"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
@@ -17,10 +18,10 @@ import (
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
-func (s Service) SentInvite(w http.ResponseWriter, r *http.Request) {
+func (s Service) CreateInvite(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -28,12 +29,19 @@ func (s Service) SentInvite(w http.ResponseWriter, r *http.Request) {
 
 	req, err := requests.CreateInvite(r)
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse create city admin request")
+		s.log(r.Context()).WithError(err).Error("failed to parse create city admin request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
 	}
 
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	var result models.Invite
 	switch initiator.Role {
 	case roles.SystemUser:
@@ -60,16 +68,18 @@ func (s Service) SentInvite(w http.ResponseWriter, r *http.Request) {
 		)
 	}
 	if err != nil {
-		s.log.WithError(err).Error("failed to create city admin")
+		s.log(r.Context()).WithError(err).Error("failed to create city admin")
 		switch {
 		case errors.Is(err, errx.ErrorNotEnoughRight):
-			ape.RenderErr(w, problems.Conflict("initiator have no rights for this action"))
+			ape.RenderErr(w, problems.Forbidden("initiator have no rights for this action"))
 		case errors.Is(err, errx.ErrorCityAdminAlreadyExists):
 			ape.RenderErr(w, problems.Conflict("city admin already exists"))
 		case errors.Is(err, errx.ErrorInvalidCityAdminRole):
 			ape.RenderErr(w, problems.BadRequest(validation.Errors{
 				"role": err,
 			})...)
+		case errors.Is(err, errx.ErrorInviteeNotFound):
+			ape.RenderErr(w, problems.NotFound("invited user not found"))
 		case errors.Is(err, errx.ErrorCityIsNotSupported):
 			ape.RenderErr(w, problems.Forbidden("cannot create invite for not official city"))
 		default:
@@ -79,7 +89,19 @@ func (s Service) SentInvite(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.log.Infof("admin %s created successfully by user %s", result.ID, initiator.ID)
+	s.log(r.Context()).Infof("invite %s created successfully by user %s", result.ID, initiator.ID)
+
+	doc := jsonapi.NewDocument(params, responses.Invite(result))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeInviteCities(r.Context(), doc, result); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include invite city")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeInviteUsers(r.Context(), doc, result)
+	}
 
-	ape.Render(w, http.StatusCreated, responses.Invite(result))
+	doc.Render(w, http.StatusCreated)
 }
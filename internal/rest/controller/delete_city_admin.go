@@ -17,7 +17,7 @@ import (
 func (s Service) DeleteCityAdmin(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -25,7 +25,7 @@ func (s Service) DeleteCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid city_id")
+		s.log(r.Context()).WithError(err).Error("invalid city_id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"city_id": err,
 		})...)
@@ -35,7 +35,7 @@ func (s Service) DeleteCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid user_id")
+		s.log(r.Context()).WithError(err).Error("invalid user_id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"user_id": err,
 		})...)
@@ -51,7 +51,7 @@ func (s Service) DeleteCityAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 
 	if err != nil {
-		s.log.WithError(err).Error("failed to delete admin")
+		s.log(r.Context()).WithError(err).Error("failed to delete admin")
 		switch {
 		case errors.Is(err, errx.ErrorCannotDeleteYourself):
 			ape.RenderErr(w, problems.Forbidden("cannot delete yourself"))
@@ -7,6 +7,7 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -14,9 +15,16 @@ import (
 )
 
 func (s Service) GetCity(w http.ResponseWriter, r *http.Request) {
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeAdmins)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid city_id")
+		s.log(r.Context()).WithError(err).Error("invalid city_id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"city_id": err,
 		})...)
@@ -26,7 +34,7 @@ func (s Service) GetCity(w http.ResponseWriter, r *http.Request) {
 
 	city, err := s.domain.city.GetByID(r.Context(), cityID)
 	if err != nil {
-		s.log.WithError(err).Error("failed to get city")
+		s.log(r.Context()).WithError(err).Error("failed to get city")
 		switch {
 		case errors.Is(err, errx.ErrorCityNotFound):
 			ape.RenderErr(w, problems.NotFound("city not found"))
@@ -37,5 +45,14 @@ func (s Service) GetCity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.City(city))
+	doc := jsonapi.NewDocument(params, responses.City(city))
+	if params.Includes(responses.IncludeAdmins) {
+		if err = s.includeCityAdmins(r.Context(), doc, city); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include city admins")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+
+	doc.Render(w, http.StatusOK)
 }
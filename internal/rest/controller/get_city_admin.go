@@ -7,6 +7,7 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -14,9 +15,16 @@ import (
 )
 
 func (s Service) GetCityAdmin(w http.ResponseWriter, r *http.Request) {
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	userID, err := uuid.Parse(chi.URLParam(r, "user_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid user_id")
+		s.log(r.Context()).WithError(err).Error("invalid user_id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"user_id": err,
 		})...)
@@ -26,7 +34,7 @@ func (s Service) GetCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid city_id")
+		s.log(r.Context()).WithError(err).Error("invalid city_id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"city_id": err,
 		})...)
@@ -36,7 +44,7 @@ func (s Service) GetCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	res, err := s.domain.admin.Get(r.Context(), userID, cityID)
 	if err != nil {
-		s.log.WithError(err).Error("failed to get admin")
+		s.log(r.Context()).WithError(err).Error("failed to get admin")
 		switch {
 		case errors.Is(err, errx.ErrorCityAdminNotFound):
 			ape.RenderErr(w, problems.NotFound("city city admin not found"))
@@ -47,5 +55,17 @@ func (s Service) GetCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.CityAdmin(res))
+	doc := jsonapi.NewDocument(params, responses.CityAdmin(res))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeAdminCities(r.Context(), doc, res); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include admin city")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeAdminUsers(r.Context(), doc, res)
+	}
+
+	doc.Render(w, http.StatusOK)
 }
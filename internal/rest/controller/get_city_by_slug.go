@@ -7,14 +7,22 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	"github.com/go-chi/chi/v5"
 )
 
 func (s Service) GetCityBySlug(w http.ResponseWriter, r *http.Request) {
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeAdmins)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	city, err := s.domain.city.GetBySlug(r.Context(), chi.URLParam(r, "slug"))
 	if err != nil {
-		s.log.WithError(err).Error("failed to get city")
+		s.log(r.Context()).WithError(err).Error("failed to get city")
 		switch {
 		case errors.Is(err, errx.ErrorCityNotFound):
 			ape.RenderErr(w, problems.NotFound("city not found"))
@@ -25,5 +33,14 @@ func (s Service) GetCityBySlug(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.City(city))
+	doc := jsonapi.NewDocument(params, responses.City(city))
+	if params.Includes(responses.IncludeAdmins) {
+		if err = s.includeCityAdmins(r.Context(), doc, city); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include city admins")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+
+	doc.Render(w, http.StatusOK)
 }
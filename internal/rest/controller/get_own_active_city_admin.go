@@ -7,6 +7,7 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/meta"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
@@ -14,9 +15,16 @@ import (
 )
 
 func (s Service) GetMyCityAdmin(w http.ResponseWriter, r *http.Request) {
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -24,7 +32,7 @@ func (s Service) GetMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	cityID, err := uuid.Parse(r.URL.Query().Get("city_id"))
 	if err != nil {
-		s.log.WithError(err).Error("invalid city_id parameter")
+		s.log(r.Context()).WithError(err).Error("invalid city_id parameter")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"city_id": errors.New("invalid city_id parameter"),
 		})...)
@@ -33,7 +41,7 @@ func (s Service) GetMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	res, err := s.domain.admin.Get(r.Context(), initiator.ID, cityID)
 	if err != nil {
-		s.log.WithError(err).Error("failed to get own active admin")
+		s.log(r.Context()).WithError(err).Error("failed to get own active admin")
 
 		switch {
 		case errors.Is(err, errx.ErrorCityAdminNotFound):
@@ -44,5 +52,17 @@ func (s Service) GetMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.CityAdmin(res))
+	doc := jsonapi.NewDocument(params, responses.CityAdmin(res))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeAdminCities(r.Context(), doc, res); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include admin city")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeAdminUsers(r.Context(), doc, res)
+	}
+
+	doc.Render(w, http.StatusOK)
 }
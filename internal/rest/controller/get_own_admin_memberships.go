@@ -0,0 +1,52 @@
+package controller
+
+import (
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	"github.com/google/uuid"
+)
+
+func (s Service) GetMyAdminMemberships(w http.ResponseWriter, r *http.Request) {
+	initiator, err := meta.User(r.Context())
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	admins, err := s.domain.admin.GetByUser(r.Context(), initiator.ID)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to get own admin memberships")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	invites, err := s.domain.invite.GetPending(r.Context(), initiator.ID)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to get own pending invites")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	cityIDs := make([]uuid.UUID, 0, len(admins)+len(invites))
+	for _, a := range admins {
+		cityIDs = append(cityIDs, a.CityID)
+	}
+	for _, inv := range invites {
+		cityIDs = append(cityIDs, inv.CityID)
+	}
+
+	cities, _, err := s.domain.city.GetByIDs(r.Context(), cityIDs)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to get cities of own admin memberships")
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, http.StatusOK, responses.AdminMemberships(initiator.ID, admins, invites, cities))
+}
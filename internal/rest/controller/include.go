@@ -0,0 +1,90 @@
+package controller
+
+import (
+	"context"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	"github.com/google/uuid"
+)
+
+func (s Service) includeCityAdmins(ctx context.Context, doc *jsonapi.Document, cities ...models.City) error {
+	ids := make([]uuid.UUID, 0, len(cities))
+	for _, c := range cities {
+		ids = append(ids, c.ID)
+	}
+
+	admins, err := s.domain.admin.GetByCities(ctx, ids...)
+	if err != nil {
+		return err
+	}
+
+	responses.IncludeCityAdmins(doc, cities, admins)
+
+	return nil
+}
+
+func (s Service) includeAdminCities(ctx context.Context, doc *jsonapi.Document, admins ...models.CityAdmin) error {
+	ids := make([]uuid.UUID, 0, len(admins))
+	for _, a := range admins {
+		ids = append(ids, a.CityID)
+	}
+
+	cities, _, err := s.domain.city.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	responses.IncludeAdminCities(doc, admins, cities)
+
+	return nil
+}
+
+func (s Service) includeInviteCities(ctx context.Context, doc *jsonapi.Document, invites ...models.Invite) error {
+	ids := make([]uuid.UUID, 0, len(invites))
+	for _, inv := range invites {
+		ids = append(ids, inv.CityID)
+	}
+
+	cities, _, err := s.domain.city.GetByIDs(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	responses.IncludeInviteCities(doc, invites, cities)
+
+	return nil
+}
+
+// includeAdminUsers embeds the profiles of the admins, profiles-svc failures
+// are logged and the document is rendered without them.
+func (s Service) includeAdminUsers(ctx context.Context, doc *jsonapi.Document, admins ...models.CityAdmin) {
+	ids := make([]uuid.UUID, 0, len(admins))
+	for _, a := range admins {
+		ids = append(ids, a.UserID)
+	}
+
+	users, err := s.profiles.GetMany(ctx, ids...)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to get admin profiles")
+		return
+	}
+
+	responses.IncludeAdminUsers(doc, admins, users)
+}
+
+func (s Service) includeInviteUsers(ctx context.Context, doc *jsonapi.Document, invites ...models.Invite) {
+	ids := make([]uuid.UUID, 0, len(invites))
+	for _, inv := range invites {
+		ids = append(ids, inv.UserID)
+	}
+
+	users, err := s.profiles.GetMany(ctx, ids...)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to get invited user profiles")
+		return
+	}
+
+	responses.IncludeInviteUsers(doc, invites, users)
+}
@@ -10,10 +10,13 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
+	"github.com/chains-lab/cities-svc/internal/rest/requests"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 
 	"github.com/chains-lab/restkit/pagi"
+	"github.com/google/uuid"
 	"github.com/paulmach/orb"
 )
 
@@ -21,8 +24,37 @@ func (s Service) ListCities(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	q := r.URL.Query()
 
+	params, err := jsonapi.ParseParams(q, responses.IncludeAdmins)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	var filters city.FilterParams
 
+	if cityIDs := q["id"]; len(cityIDs) > 0 {
+		if len(cityIDs) > requests.MaxBatchSize {
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"id": fmt.Errorf("must be at most %d ids", requests.MaxBatchSize),
+			})...)
+			return
+		}
+
+		ids := make([]uuid.UUID, 0, len(cityIDs))
+		for _, idStr := range cityIDs {
+			id, err := uuid.Parse(idStr)
+			if err != nil {
+				ape.RenderErr(w, problems.BadRequest(validation.Errors{
+					"id": err,
+				})...)
+				return
+			}
+			ids = append(ids, id)
+		}
+		filters.ID = ids
+	}
+
 	if name := strings.TrimSpace(q.Get("name")); name != "" {
 		filters.Name = &[]string{name}[0]
 	}
@@ -101,7 +133,7 @@ func (s Service) ListCities(w http.ResponseWriter, r *http.Request) {
 
 	cities, err := s.domain.city.Filter(ctx, filters, page, size)
 	if err != nil {
-		s.log.WithError(err).Error("failed to search cities")
+		s.log(ctx).WithError(err).Error("failed to search cities")
 		switch {
 		default:
 			ape.RenderErr(w, problems.InternalError())
@@ -109,5 +141,14 @@ func (s Service) ListCities(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.CitiesCollection(cities))
+	doc := jsonapi.NewDocument(params, responses.CitiesCollection(cities))
+	if params.Includes(responses.IncludeAdmins) {
+		if err = s.includeCityAdmins(ctx, doc, cities.Data...); err != nil {
+			s.log(ctx).WithError(err).Error("failed to include city admins")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+
+	doc.Render(w, http.StatusOK)
 }
@@ -0,0 +1,79 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/chains-lab/cities-svc/internal/rest/requests"
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+func TestListCitiesByID(t *testing.T) {
+	db := memory.New()
+	// listing never publishes events
+	ctrl := New(logium.NewLogger("error", "text"), city.NewService(db, nil), nil, nil, nil, nil, 0)
+
+	var ids []string
+	for _, name := range []string{"Kyiv", "Lviv", "Odesa"} {
+		now := time.Now().UTC()
+		c, err := db.CreateCity(context.Background(), models.City{
+			ID:        uuid.New(),
+			CountryID: "UKR",
+			Point:     orb.Point{30.5234, 50.4501},
+			Status:    enum.CityStatusSupported,
+			Name:      name,
+			Timezone:  "Europe/Kyiv",
+			CreatedAt: now,
+			UpdatedAt: now,
+		})
+		if err != nil {
+			t.Fatalf("create city %s: %v", name, err)
+		}
+		ids = append(ids, c.ID.String())
+	}
+
+	list := func(ids ...string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		ctrl.ListCities(rec, httptest.NewRequest(http.MethodGet, "/cities?"+url.Values{"id": ids}.Encode(), nil))
+		return rec
+	}
+
+	rec := list(ids[0], ids[2], uuid.NewString())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, body %s", rec.Code, rec.Body)
+	}
+	var doc struct {
+		Data []struct {
+			ID string `json:"id"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &doc); err != nil {
+		t.Fatalf("decode: %v", err)
+	}
+	if len(doc.Data) != 2 {
+		t.Errorf("got %d cities, want Kyiv and Odesa", len(doc.Data))
+	}
+
+	tooMany := make([]string, requests.MaxBatchSize+1)
+	for i := range tooMany {
+		tooMany[i] = uuid.NewString()
+	}
+	if rec := list(tooMany...); rec.Code != http.StatusBadRequest {
+		t.Errorf("%d ids: status = %d, want %d", len(tooMany), rec.Code, http.StatusBadRequest)
+	}
+
+	if rec := list("kyiv"); rec.Code != http.StatusBadRequest {
+		t.Errorf("invalid id: status = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
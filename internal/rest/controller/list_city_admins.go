@@ -6,6 +6,7 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 
@@ -17,6 +18,13 @@ func (s Service) ListAdmins(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	q := r.URL.Query()
 
+	params, err := jsonapi.ParseParams(q, responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
 	var filters admin.FilterParams
 
 	if userIDs := q["user_id"]; len(userIDs) > 0 {
@@ -66,7 +74,7 @@ func (s Service) ListAdmins(w http.ResponseWriter, r *http.Request) {
 
 	admins, err := s.domain.admin.Filter(ctx, filters, page, size)
 	if err != nil {
-		s.log.WithError(err).Error("failed to search admins")
+		s.log(ctx).WithError(err).Error("failed to search admins")
 		switch {
 		default:
 			ape.RenderErr(w, problems.InternalError())
@@ -75,5 +83,17 @@ func (s Service) ListAdmins(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	ape.Render(w, http.StatusOK, responses.CityAdminsCollection(admins))
+	doc := jsonapi.NewDocument(params, responses.CityAdminsCollection(admins))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeAdminCities(ctx, doc, admins.Data...); err != nil {
+			s.log(ctx).WithError(err).Error("failed to include admin cities")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeAdminUsers(ctx, doc, admins.Data...)
+	}
+
+	doc.Render(w, http.StatusOK)
 }
@@ -0,0 +1,94 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	"github.com/chains-lab/restkit/pagi"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) ListCityInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	q := r.URL.Query()
+
+	initiator, err := meta.User(ctx)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
+	if err != nil {
+		s.log(ctx).WithError(err).Error("invalid city_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"city_id": err,
+		})...)
+
+		return
+	}
+
+	params, err := jsonapi.ParseParams(q, responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	filters := invite.FilterParams{
+		Status: q["status"],
+	}
+
+	page, size := pagi.GetPagination(r)
+
+	var invites models.InvitesCollection
+	switch initiator.Role {
+	case roles.SystemUser:
+		invites, err = s.domain.invite.FilterByCityAdmin(ctx, initiator.ID, cityID, filters, page, size)
+	default:
+		filters.CityID = []uuid.UUID{cityID}
+		invites, err = s.domain.invite.Filter(ctx, filters, page, size)
+	}
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to list city invites")
+		switch {
+		case errors.Is(err, errx.ErrorNotEnoughRight):
+			ape.RenderErr(w, problems.Forbidden("initiator have no rights for this action"))
+		case errors.Is(err, errx.ErrorInvalidInviteStatus):
+			ape.RenderErr(w, problems.BadRequest(validation.Errors{
+				"status": err,
+			})...)
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	doc := jsonapi.NewDocument(params, responses.InvitesCollection(invites))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeInviteCities(ctx, doc, invites.Data...); err != nil {
+			s.log(ctx).WithError(err).Error("failed to include invite cities")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeInviteUsers(ctx, doc, invites.Data...)
+	}
+
+	doc.Render(w, http.StatusOK)
+}
@@ -16,7 +16,7 @@ import (
 func (s Service) RefuseMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		http.Error(w, "failed to get user from context", http.StatusUnauthorized)
 
 		return
@@ -24,7 +24,7 @@ func (s Service) RefuseMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse city_id param")
+		s.log(r.Context()).WithError(err).Error("failed to parse city_id param")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"city_id": err,
 		})...)
@@ -34,7 +34,7 @@ func (s Service) RefuseMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	err = s.domain.admin.DeleteOwn(r.Context(), initiator.ID, cityID)
 	if err != nil {
-		s.log.WithError(err).Error("failed to refuse own admin")
+		s.log(r.Context()).WithError(err).Error("failed to refuse own admin")
 		switch {
 		case errors.Is(err, errx.ErrorNotEnoughRight):
 			ape.RenderErr(w, problems.Forbidden("no active city admin for the user"))
@@ -48,7 +48,7 @@ func (s Service) RefuseMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.log.Infof("user %s refused own admin successfully", initiator.ID)
+	s.log(r.Context()).Infof("user %s refused own admin successfully", initiator.ID)
 
 	w.WriteHeader(http.StatusNoContent)
 }
@@ -0,0 +1,89 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+func (s Service) AcceptInvite(w http.ResponseWriter, r *http.Request) {
+	s.replyInvite(w, r, enum.InviteStatusAccepted)
+}
+
+func (s Service) DeclineInvite(w http.ResponseWriter, r *http.Request) {
+	s.replyInvite(w, r, enum.InviteStatusDeclined)
+}
+
+func (s Service) replyInvite(w http.ResponseWriter, r *http.Request, answer string) {
+	initiator, err := meta.User(r.Context())
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	inviteID, err := uuid.Parse(chi.URLParam(r, "invite_id"))
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid invite_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"invite_id": err,
+		})...)
+
+		return
+	}
+
+	params, err := jsonapi.ParseParams(r.URL.Query(), responses.IncludeCity, responses.IncludeUser)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("invalid include or fields parameters")
+		ape.RenderErr(w, problems.BadRequest(err)...)
+		return
+	}
+
+	res, err := s.domain.invite.Reply(r.Context(), initiator.ID, inviteID, answer)
+	if err != nil {
+		s.log(r.Context()).WithError(err).Error("failed to answer to invite")
+		switch {
+		case errors.Is(err, errx.ErrorInviteNotFound):
+			ape.RenderErr(w, problems.NotFound("invite not found"))
+		case errors.Is(err, errx.ErrorNotEnoughRight):
+			ape.RenderErr(w, problems.Forbidden("invite is addressed to another user"))
+		case errors.Is(err, errx.ErrorInviteAlreadyReplied):
+			ape.RenderErr(w, problems.Conflict("invite already answered"))
+		case errors.Is(err, errx.ErrorInviteExpired):
+			ape.RenderErr(w, problems.Conflict("invite expired"))
+		case errors.Is(err, errx.ErrorCityAdminAlreadyExists):
+			ape.RenderErr(w, problems.Conflict("user is already s city admin"))
+		case errors.Is(err, errx.ErrorCityIsNotSupported):
+			ape.RenderErr(w, problems.Forbidden("cannot accept invite for not official support city"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	doc := jsonapi.NewDocument(params, responses.Invite(res))
+	if params.Includes(responses.IncludeCity) {
+		if err = s.includeInviteCities(r.Context(), doc, res); err != nil {
+			s.log(r.Context()).WithError(err).Error("failed to include invite city")
+			ape.RenderErr(w, problems.InternalError())
+			return
+		}
+	}
+	if params.Includes(responses.IncludeUser) {
+		s.includeInviteUsers(r.Context(), doc, res)
+	}
+
+	doc.Render(w, http.StatusOK)
+}
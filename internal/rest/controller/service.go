@@ -2,15 +2,20 @@ package controller
 
 import (
 	"context"
+	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
 	"github.com/chains-lab/cities-svc/internal/domain/services/city"
 	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/chains-lab/cities-svc/internal/stream"
 
 	"github.com/chains-lab/logium"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb"
+	"github.com/sirupsen/logrus"
 )
 
 type CityAdminSvc interface {
@@ -21,6 +26,9 @@ type CityAdminSvc interface {
 	) (models.CityAdminsCollection, error)
 
 	Get(ctx context.Context, userID, cityID uuid.UUID) (models.CityAdmin, error)
+	GetByKeys(ctx context.Context, keys []admin.Key) ([]models.CityAdmin, []admin.Key, error)
+	GetByUser(ctx context.Context, userID uuid.UUID) ([]models.CityAdmin, error)
+	GetByCities(ctx context.Context, cityIDs ...uuid.UUID) ([]models.CityAdmin, error)
 
 	DeleteOwn(ctx context.Context, userID, cityID uuid.UUID) error
 
@@ -60,6 +68,7 @@ type CitySvc interface {
 	) (models.CitiesCollection, error)
 
 	GetByID(ctx context.Context, cityID uuid.UUID) (models.City, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.City, []uuid.UUID, error)
 	GetByRadius(ctx context.Context, point orb.Point, radius uint64) (models.City, error)
 	GetBySlug(ctx context.Context, slug string) (models.City, error)
 
@@ -87,6 +96,29 @@ type inviteSvc interface {
 		userID, inviteID uuid.UUID,
 		answer string,
 	) (models.Invite, error)
+
+	GetPending(ctx context.Context, userID uuid.UUID) ([]models.Invite, error)
+
+	Filter(
+		ctx context.Context,
+		filters invite.FilterParams,
+		page, size uint64,
+	) (models.InvitesCollection, error)
+	FilterByCityAdmin(
+		ctx context.Context,
+		initiatorID, cityID uuid.UUID,
+		filters invite.FilterParams,
+		page, size uint64,
+	) (models.InvitesCollection, error)
+}
+
+type ProfileSvc interface {
+	GetMany(ctx context.Context, userIDs ...uuid.UUID) (map[uuid.UUID]profiles.Profile, error)
+}
+
+type CityEvents interface {
+	Subscribe(cityID uuid.UUID, lastEventID string) (*stream.Subscription, []stream.Event, bool)
+	Unsubscribe(sub *stream.Subscription)
 }
 
 type domain struct {
@@ -96,17 +128,37 @@ type domain struct {
 }
 
 type Service struct {
-	domain domain
-	log    logium.Logger
+	domain    domain
+	profiles  ProfileSvc
+	events    CityEvents
+	heartbeat time.Duration
+	logger    logium.Logger
 }
 
-func New(log logium.Logger, city CitySvc, cityMod CityAdminSvc, invSvc inviteSvc) Service {
+func New(
+	log logium.Logger,
+	city CitySvc,
+	cityMod CityAdminSvc,
+	invSvc inviteSvc,
+	profiles ProfileSvc,
+	events CityEvents,
+	heartbeat time.Duration,
+) Service {
 	return Service{
-		log: log,
+		logger: log,
 		domain: domain{
 			city:   city,
 			admin:  cityMod,
 			invite: invSvc,
 		},
+		profiles:  profiles,
+		events:    events,
+		heartbeat: heartbeat,
 	}
 }
+
+// log returns the logger of the request handled under ctx, tagged with its
+// request id.
+func (s Service) log(ctx context.Context) logrus.FieldLogger {
+	return requestid.Log(ctx, s.logger)
+}
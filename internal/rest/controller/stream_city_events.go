@@ -0,0 +1,125 @@
+package controller
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/cities-svc/internal/stream"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/go-chi/chi/v5"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/google/uuid"
+)
+
+// ResyncEvent tells the client that events were missed and it has to reload
+// the city and its admins before relying on the stream again.
+const ResyncEvent = "resync"
+
+func (s Service) StreamCityEvents(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	initiator, err := meta.User(ctx)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to get user from context")
+		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+
+		return
+	}
+
+	cityID, err := uuid.Parse(chi.URLParam(r, "city_id"))
+	if err != nil {
+		s.log(ctx).WithError(err).Error("invalid city_id")
+		ape.RenderErr(w, problems.BadRequest(validation.Errors{
+			"city_id": err,
+		})...)
+
+		return
+	}
+
+	if _, err = s.domain.city.GetByID(ctx, cityID); err != nil {
+		s.log(ctx).WithError(err).Error("failed to get city")
+		switch {
+		case errors.Is(err, errx.ErrorCityNotFound):
+			ape.RenderErr(w, problems.NotFound("city not found"))
+		default:
+			ape.RenderErr(w, problems.InternalError())
+		}
+
+		return
+	}
+
+	if initiator.Role == roles.SystemUser {
+		if _, err = s.domain.admin.Get(ctx, initiator.ID, cityID); err != nil {
+			s.log(ctx).WithError(err).Error("failed to get initiator city admin")
+			switch {
+			case errors.Is(err, errx.ErrorCityAdminNotFound):
+				ape.RenderErr(w, problems.Forbidden("initiator is not an admin of the city"))
+			default:
+				ape.RenderErr(w, problems.InternalError())
+			}
+
+			return
+		}
+	}
+
+	rc := http.NewResponseController(w)
+	// Streams outlive the server write timeout.
+	if err = rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		s.log(ctx).WithError(err).Error("failed to clear write deadline")
+	}
+
+	sub, missed, ok := s.events.Subscribe(cityID, r.Header.Get("Last-Event-ID"))
+	defer s.events.Unsubscribe(sub)
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	if !ok {
+		_, err = fmt.Fprintf(w, "event: %s\ndata: {}\n\n", ResyncEvent)
+	}
+	for _, event := range missed {
+		if err != nil {
+			break
+		}
+		err = writeEvent(w, event)
+	}
+	if err == nil {
+		err = rc.Flush()
+	}
+
+	heartbeat := time.NewTicker(s.heartbeat)
+	defer heartbeat.Stop()
+
+	for err == nil {
+		select {
+		case <-ctx.Done():
+			return
+		case event, open := <-sub.C:
+			if !open {
+				// Fell behind, the client resumes from Last-Event-ID.
+				return
+			}
+			err = writeEvent(w, event)
+		case <-heartbeat.C:
+			_, err = fmt.Fprint(w, ": heartbeat\n\n")
+		}
+		if err == nil {
+			err = rc.Flush()
+		}
+	}
+
+	s.log(ctx).WithError(err).Debug("city events stream closed")
+}
+
+func writeEvent(w http.ResponseWriter, event stream.Event) error {
+	_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", event.ID, event.Type, event.Data)
+	return err
+}
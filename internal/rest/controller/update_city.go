@@ -21,7 +21,7 @@ import (
 func (s Service) UpdateCity(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -29,7 +29,7 @@ func (s Service) UpdateCity(w http.ResponseWriter, r *http.Request) {
 
 	req, err := requests.UpdateCity(r)
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse update city request")
+		s.log(r.Context()).WithError(err).Error("failed to parse update city request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
@@ -64,7 +64,7 @@ func (s Service) UpdateCity(w http.ResponseWriter, r *http.Request) {
 		res, err = s.domain.city.UpdateByAdmin(r.Context(), req.Data.Id, param)
 	}
 	if err != nil {
-		s.log.WithError(err).Error("failed to update city")
+		s.log(r.Context()).WithError(err).Error("failed to update city")
 		switch {
 		case errors.Is(err, errx.ErrorNotEnoughRight):
 			ape.RenderErr(w, problems.Forbidden("not enough rights to update city"))
@@ -101,7 +101,7 @@ func (s Service) UpdateCity(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	s.log.Infof("city %s updated by user %s", res.ID, initiator.ID)
+	s.log(r.Context()).Infof("city %s updated by user %s", res.ID, initiator.ID)
 
 	ape.Render(w, http.StatusOK, responses.City(res))
 }
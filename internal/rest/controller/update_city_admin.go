@@ -21,7 +21,7 @@ import (
 func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 	req, err := requests.UpdateCityAmin(r)
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse update city admin request")
+		s.log(r.Context()).WithError(err).Error("failed to parse update city admin request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
@@ -29,7 +29,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -37,7 +37,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	ids := strings.Split(req.Data.Id, ":")
 	if len(ids) != 2 {
-		s.log.Error("invalid city admin id format")
+		s.log(r.Context()).Error("invalid city admin id format")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid id: %s, need format uuid:uuid look like user_id:city_id", req.Data.Id),
 		})...)
@@ -46,7 +46,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 	userID, err := uuid.Parse(ids[0])
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse user id from city admin id")
+		s.log(r.Context()).WithError(err).Error("failed to parse user id from city admin id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid user id in city admin id: %s", ids[0]),
 		})...)
@@ -55,7 +55,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 	cityID, err := uuid.Parse(ids[1])
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse city id from city admin id")
+		s.log(r.Context()).WithError(err).Error("failed to parse city id from city admin id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid city id in city admin id: %s", ids[1]),
 		})...)
@@ -63,7 +63,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if cityID.String() != chi.URLParam(r, "city_id") {
-		s.log.Error("city_id in url and city_id in body do not match")
+		s.log(r.Context()).Error("city_id in url and city_id in body do not match")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("city_id in url and city_id in body do not match"),
 		})...)
@@ -71,7 +71,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if userID.String() != chi.URLParam(r, "user_id") {
-		s.log.Error("user_id in url and user_id in body do not match")
+		s.log(r.Context()).Error("user_id in url and user_id in body do not match")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("user_id in url and user_id in body do not match"),
 		})...)
@@ -94,7 +94,7 @@ func (s Service) UpdateCityAdmin(w http.ResponseWriter, r *http.Request) {
 		})
 	}
 	if err != nil {
-		s.log.WithError(err).Error("failed to update city admin")
+		s.log(r.Context()).WithError(err).Error("failed to update city admin")
 		ape.RenderErr(w, problems.InternalError())
 
 		return
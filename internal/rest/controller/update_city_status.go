@@ -8,9 +8,11 @@ import (
 	"github.com/chains-lab/ape"
 	"github.com/chains-lab/ape/problems"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/internal/rest/meta"
 	"github.com/chains-lab/cities-svc/internal/rest/requests"
 	"github.com/chains-lab/cities-svc/internal/rest/responses"
+	"github.com/chains-lab/restkit/roles"
 	"github.com/go-chi/chi/v5"
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -18,7 +20,7 @@ import (
 func (s Service) UpdateCityStatus(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -26,13 +28,13 @@ func (s Service) UpdateCityStatus(w http.ResponseWriter, r *http.Request) {
 
 	req, err := requests.UpdateCityStatus(r)
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse update city request")
+		s.log(r.Context()).WithError(err).Error("failed to parse update city request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
 	}
 	if req.Data.Id.String() != chi.URLParam(r, "city_id") {
-		s.log.Error("city_id in url and city_id in body do not match")
+		s.log(r.Context()).Error("city_id in url and city_id in body do not match")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("city_id in url and city_id in body do not match"),
 		})...)
@@ -40,10 +42,23 @@ func (s Service) UpdateCityStatus(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	res, err := s.domain.city.UpdateStatusByCityAdmin(r.Context(), initiator.ID, req.Data.Id, req.Data.Attributes.Status)
+	var res models.City
+	switch initiator.Role {
+	case roles.SystemUser:
+		res, err = s.domain.city.UpdateStatusByCityAdmin(r.Context(), initiator.ID, req.Data.Id, req.Data.Attributes.Status)
+	case roles.SystemAdmin:
+		res, err = s.domain.city.UpdateStatusBySysAdmin(r.Context(), req.Data.Id, req.Data.Attributes.Status)
+	default:
+		s.log(r.Context()).Errorf("role %s cannot update city status", initiator.Role)
+		ape.RenderErr(w, problems.Forbidden("not enough rights to update city status"))
+
+		return
+	}
 	if err != nil {
-		s.log.WithError(err).Error("failed to update city status")
+		s.log(r.Context()).WithError(err).Error("failed to update city status")
 		switch {
+		case errors.Is(err, errx.ErrorNotEnoughRight):
+			ape.RenderErr(w, problems.Forbidden("not enough rights to update city status"))
 		case errors.Is(err, errx.ErrorCityNotFound):
 			ape.RenderErr(w, problems.NotFound("city not found"))
 		case errors.Is(err, errx.ErrorInvalidCityStatus):
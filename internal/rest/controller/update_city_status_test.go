@@ -0,0 +1,69 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/chains-lab/restkit/token"
+	"github.com/go-chi/chi/v5"
+	"github.com/google/uuid"
+)
+
+// statusCities records which status update path a request took.
+type statusCities struct {
+	CitySvc
+	called string
+}
+
+func (c *statusCities) UpdateStatusByCityAdmin(_ context.Context, _, cityID uuid.UUID, status string) (models.City, error) {
+	c.called = "city admin"
+	return models.City{ID: cityID, Status: status}, nil
+}
+
+func (c *statusCities) UpdateStatusBySysAdmin(_ context.Context, cityID uuid.UUID, status string) (models.City, error) {
+	c.called = "sysadmin"
+	return models.City{ID: cityID, Status: status}, nil
+}
+
+func TestUpdateCityStatusRoles(t *testing.T) {
+	for _, tc := range []struct {
+		role   string
+		code   int
+		called string
+	}{
+		{role: roles.SystemUser, code: http.StatusOK, called: "city admin"},
+		{role: roles.SystemAdmin, code: http.StatusOK, called: "sysadmin"},
+		{role: roles.SystemSuperUser, code: http.StatusForbidden},
+		{role: "guest", code: http.StatusForbidden},
+	} {
+		t.Run(tc.role, func(t *testing.T) {
+			cities := &statusCities{}
+			ctrl := New(logium.NewLogger("error", "text"), cities, nil, nil, nil, nil, 0)
+
+			cityID := uuid.New()
+			body := fmt.Sprintf(`{"data":{"id":%q,"type":"city","attributes":{"status":%q}}}`, cityID, enum.CityStatusSuspended)
+			req := httptest.NewRequest(http.MethodPatch, "/", strings.NewReader(body))
+
+			rctx := chi.NewRouteContext()
+			rctx.URLParams.Add("city_id", cityID.String())
+			ctx := context.WithValue(req.Context(), chi.RouteCtxKey, rctx)
+			ctx = context.WithValue(ctx, meta.UserCtxKey, token.UserData{ID: uuid.New(), Role: tc.role})
+
+			rec := httptest.NewRecorder()
+			ctrl.UpdateCityStatus(rec, req.WithContext(ctx))
+
+			if rec.Code != tc.code || cities.called != tc.called {
+				t.Errorf("status = %d via %q, want %d via %q, body %s", rec.Code, cities.called, tc.code, tc.called, rec.Body)
+			}
+		})
+	}
+}
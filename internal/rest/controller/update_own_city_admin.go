@@ -22,7 +22,7 @@ import (
 func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 	initiator, err := meta.User(r.Context())
 	if err != nil {
-		s.log.WithError(err).Error("failed to get user from context")
+		s.log(r.Context()).WithError(err).Error("failed to get user from context")
 		ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
 
 		return
@@ -30,7 +30,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	req, err := requests.UpdateOwnCityAdmin(r)
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse update own active admin request")
+		s.log(r.Context()).WithError(err).Error("failed to parse update own active admin request")
 		ape.RenderErr(w, problems.BadRequest(err)...)
 
 		return
@@ -38,7 +38,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	ids := strings.Split(req.Data.Id, ":")
 	if len(ids) != 2 {
-		s.log.Error("invalid city admin id format")
+		s.log(r.Context()).Error("invalid city admin id format")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid id: %s, need format uuid:uuid look like user_id:city_id", req.Data.Id),
 		})...)
@@ -47,7 +47,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 	userID, err := uuid.Parse(ids[0])
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse user id from city admin id")
+		s.log(r.Context()).WithError(err).Error("failed to parse user id from city admin id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid user id in city admin id: %s", ids[0]),
 		})...)
@@ -56,7 +56,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 	}
 	cityID, err := uuid.Parse(ids[1])
 	if err != nil {
-		s.log.WithError(err).Error("failed to parse city id from city admin id")
+		s.log(r.Context()).WithError(err).Error("failed to parse city id from city admin id")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("invalid city id in city admin id: %s", ids[1]),
 		})...)
@@ -64,7 +64,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if cityID.String() != chi.URLParam(r, "city_id") {
-		s.log.Error("city_id in url and city_id in body do not match")
+		s.log(r.Context()).Error("city_id in url and city_id in body do not match")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": fmt.Errorf("city_id in url and city_id in body do not match"),
 		})...)
@@ -72,7 +72,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	if initiator.ID.String() != userID.String() {
-		s.log.Error("user ID does not match request ID")
+		s.log(r.Context()).Error("user ID does not match request ID")
 		ape.RenderErr(w, problems.BadRequest(validation.Errors{
 			"id": errors.New("user ID does not match request ID"),
 		})...)
@@ -86,7 +86,7 @@ func (s Service) UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request) {
 
 	res, err := s.domain.admin.UpdateOwn(r.Context(), userID, cityID, params)
 	if err != nil {
-		s.log.WithError(err).Error("failed to update own active admin")
+		s.log(r.Context()).WithError(err).Error("failed to update own active admin")
 		switch {
 		case errors.Is(err, errx.ErrorNotEnoughRight):
 			ape.RenderErr(w, problems.Forbidden("only active city admin can update their admin info"))
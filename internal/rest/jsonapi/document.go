@@ -0,0 +1,163 @@
+package jsonapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+)
+
+// Identifier is a JSON:API resource identifier object.
+type Identifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// Document builds a compound document around a rendered resource document,
+// adding included resources, relationships and applying sparse fieldsets.
+type Document struct {
+	params   Params
+	doc      any
+	included []any
+	rels     map[Identifier]map[string]any
+}
+
+func NewDocument(params Params, doc any) *Document {
+	return &Document{
+		params: params,
+		doc:    doc,
+		rels:   make(map[Identifier]map[string]any),
+	}
+}
+
+// Include adds resource objects to the `included` member of the document.
+func (d *Document) Include(res ...any) {
+	d.included = append(d.included, res...)
+}
+
+// RelateOne sets a to-one relationship of the resource from.
+func (d *Document) RelateOne(from Identifier, name string, to Identifier) {
+	d.relate(from, name, to)
+}
+
+// RelateMany sets a to-many relationship of the resource from.
+func (d *Document) RelateMany(from Identifier, name string, to ...Identifier) {
+	if to == nil {
+		to = []Identifier{}
+	}
+	d.relate(from, name, to)
+}
+
+func (d *Document) relate(from Identifier, name string, data any) {
+	if d.rels[from] == nil {
+		d.rels[from] = make(map[string]any)
+	}
+	d.rels[from][name] = map[string]any{"data": data}
+}
+
+func (d *Document) Build() (map[string]any, error) {
+	res, err := toMap(d.doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode document: %w", err)
+	}
+
+	primary := make(map[Identifier]bool)
+	switch data := res["data"].(type) {
+	case map[string]any:
+		primary[d.shape(data)] = true
+	case []any:
+		for _, item := range data {
+			if obj, ok := item.(map[string]any); ok {
+				primary[d.shape(obj)] = true
+			}
+		}
+	}
+
+	if len(d.params.include) == 0 {
+		return res, nil
+	}
+
+	included := make([]any, 0, len(d.included))
+	seen := make(map[Identifier]bool, len(d.included))
+	for _, item := range d.included {
+		obj, err := toMap(item)
+		if err != nil {
+			return nil, fmt.Errorf("encode included resource: %w", err)
+		}
+
+		id := d.shape(obj)
+		if primary[id] || seen[id] {
+			continue
+		}
+		seen[id] = true
+
+		included = append(included, obj)
+	}
+	res["included"] = included
+
+	return res, nil
+}
+
+func (d *Document) Render(w http.ResponseWriter, status int) {
+	res, err := d.Build()
+	if err != nil {
+		ape.RenderErr(w, problems.InternalError())
+		return
+	}
+
+	ape.Render(w, status, res)
+}
+
+// shape adds the relationships of the resource object and applies the sparse fieldset of its type.
+func (d *Document) shape(obj map[string]any) Identifier {
+	typ, _ := obj["type"].(string)
+	id, _ := obj["id"].(string)
+	ident := Identifier{Type: typ, ID: id}
+
+	if rels, ok := d.rels[ident]; ok {
+		obj["relationships"] = maps.Clone(rels)
+	}
+
+	fields, ok := d.params.fields[typ]
+	if !ok {
+		return ident
+	}
+
+	for _, member := range []string{"attributes", "relationships"} {
+		values, ok := obj[member].(map[string]any)
+		if !ok {
+			continue
+		}
+		for name := range values {
+			if !fields[name] {
+				delete(values, name)
+			}
+		}
+	}
+	if rels, ok := obj["relationships"].(map[string]any); ok && len(rels) == 0 {
+		delete(obj, "relationships")
+	}
+
+	return ident
+}
+
+func toMap(v any) (map[string]any, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	var res map[string]any
+	if err = dec.Decode(&res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,93 @@
+package jsonapi
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+type testResource struct {
+	ID         string         `json:"id"`
+	Type       string         `json:"type"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+type testDocument struct {
+	Data []testResource `json:"data"`
+}
+
+func TestParseParams(t *testing.T) {
+	if _, err := ParseParams(url.Values{"include": {"city"}}, "admins"); err == nil {
+		t.Fatal("expected error for unsupported include")
+	}
+
+	p, err := ParseParams(url.Values{
+		"include":      {"admins"},
+		"fields[city]": {"name, slug"},
+	}, "admins")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !p.Includes("admins") {
+		t.Fatal("admins must be included")
+	}
+	if !p.fields["city"]["name"] || !p.fields["city"]["slug"] || len(p.fields["city"]) != 2 {
+		t.Fatalf("unexpected fields: %v", p.fields)
+	}
+}
+
+func TestDocumentBuild(t *testing.T) {
+	p, err := ParseParams(url.Values{
+		"include":            {"admins"},
+		"fields[city]":       {"name,admins"},
+		"fields[city_admin]": {"role"},
+	}, "admins")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	city := Identifier{Type: "city", ID: "c1"}
+	admin := Identifier{Type: "city_admin", ID: "u1:c1"}
+
+	doc := NewDocument(p, testDocument{Data: []testResource{{
+		ID:         city.ID,
+		Type:       city.Type,
+		Attributes: map[string]any{"name": "Kyiv", "slug": "kyiv", "timezone": "Europe/Kyiv"},
+	}}})
+	adminRes := testResource{
+		ID:         admin.ID,
+		Type:       admin.Type,
+		Attributes: map[string]any{"role": "moderator", "label": "x"},
+	}
+	doc.Include(adminRes, adminRes)
+	doc.RelateMany(city, "admins", admin)
+
+	res, err := doc.Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	raw, err := json.Marshal(res)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `{"data":[{"attributes":{"name":"Kyiv"},"id":"c1","relationships":{"admins":{"data":[{"type":"city_admin","id":"u1:c1"}]}},"type":"city"}],` +
+		`"included":[{"attributes":{"role":"moderator"},"id":"u1:c1","type":"city_admin"}]}`
+	if string(raw) != want {
+		t.Fatalf("unexpected document:\n got %s\nwant %s", raw, want)
+	}
+}
+
+func TestDocumentWithoutInclude(t *testing.T) {
+	doc := NewDocument(Params{}, testDocument{Data: []testResource{{ID: "c1", Type: "city"}}})
+	doc.Include(testResource{ID: "u1:c1", Type: "city_admin"})
+
+	res, err := doc.Build()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := res["included"]; ok {
+		t.Fatal("included must be omitted when nothing is requested")
+	}
+}
@@ -0,0 +1,69 @@
+package jsonapi
+
+import (
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// Params are the include and sparse fieldsets query parameters of a JSON:API request.
+type Params struct {
+	include map[string]bool
+	fields  map[string]map[string]bool
+}
+
+// ParseParams reads `include` and `fields[type]` from the query, includes lists
+// the relationships the endpoint is able to include.
+func ParseParams(q url.Values, includes ...string) (Params, error) {
+	p := Params{
+		include: make(map[string]bool),
+		fields:  make(map[string]map[string]bool),
+	}
+
+	if raw := q.Get("include"); raw != "" {
+		for _, rel := range strings.Split(raw, ",") {
+			rel = strings.TrimSpace(rel)
+			if !slices.Contains(includes, rel) {
+				if len(includes) == 0 {
+					return Params{}, validation.Errors{
+						"include": fmt.Errorf("include is not supported"),
+					}
+				}
+				return Params{}, validation.Errors{
+					"include": fmt.Errorf("unsupported include %q, must be one of: %s", rel, strings.Join(includes, ", ")),
+				}
+			}
+
+			p.include[rel] = true
+		}
+	}
+
+	for key, values := range q {
+		typ, ok := strings.CutPrefix(key, "fields[")
+		if !ok || !strings.HasSuffix(typ, "]") {
+			continue
+		}
+		typ = strings.TrimSuffix(typ, "]")
+
+		set := make(map[string]bool)
+		for _, v := range values {
+			for _, field := range strings.Split(v, ",") {
+				if field = strings.TrimSpace(field); field != "" {
+					set[field] = true
+				}
+			}
+		}
+
+		p.fields[typ] = set
+	}
+
+	return p, nil
+}
+
+// Includes reports whether the relationship was requested with `include`.
+func (p Params) Includes(rel string) bool {
+	return p.include[rel]
+}
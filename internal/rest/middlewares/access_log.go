@@ -0,0 +1,76 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// RequestID takes the request id from the X-Request-ID header, or generates
+// one if it is missing or malformed, returns it in the response header and
+// stores it in the request context.
+func (s Service) RequestID() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			id := r.Header.Get(requestid.Header)
+			if !requestid.Valid(id) {
+				id = requestid.New()
+			}
+
+			w.Header().Set(requestid.Header, id)
+			next.ServeHTTP(w, r.WithContext(requestid.With(r.Context(), id)))
+		})
+	}
+}
+
+type accessEntryKey struct{}
+
+// accessEntry collects what is only known deeper in the chain, Auth fills in
+// the user.
+type accessEntry struct {
+	userID uuid.UUID
+}
+
+// AccessLog writes a line per request with the route pattern, status, latency
+// and user. It has to run after RequestID.
+func (s Service) AccessLog() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			start := time.Now()
+			entry := &accessEntry{}
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+
+			next.ServeHTTP(ww, r.WithContext(context.WithValue(r.Context(), accessEntryKey{}, entry)))
+
+			route := ""
+			if rctx := chi.RouteContext(r.Context()); rctx != nil {
+				route = rctx.RoutePattern()
+			}
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+
+			fields := logrus.Fields{
+				"method":      r.Method,
+				"route":       route,
+				"path":        r.URL.Path,
+				"status":      status,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"bytes":       ww.BytesWritten(),
+			}
+			if entry.userID != uuid.Nil {
+				fields["user_id"] = entry.userID.String()
+			}
+
+			s.log(r.Context()).WithFields(fields).Info("request handled")
+		})
+	}
+}
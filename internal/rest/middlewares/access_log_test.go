@@ -0,0 +1,72 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+)
+
+func TestRequestID(t *testing.T) {
+	var got string
+	h := Service{}.RequestID()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = requestid.From(r.Context())
+	}))
+
+	cases := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{name: "propagated", header: "req-42", keep: true},
+		{name: "missing"},
+		{name: "malformed", header: "bad id\n"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/cities", nil)
+			if tc.header != "" {
+				r.Header.Set(requestid.Header, tc.header)
+			}
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, r)
+
+			if tc.keep && got != tc.header {
+				t.Errorf("request id = %q, want %q", got, tc.header)
+			}
+			if !tc.keep && (got == tc.header || !requestid.Valid(got)) {
+				t.Errorf("request id = %q, want a generated one", got)
+			}
+			if w.Header().Get(requestid.Header) != got {
+				t.Errorf("response header = %q, want %q", w.Header().Get(requestid.Header), got)
+			}
+		})
+	}
+}
+
+func TestAccessLog(t *testing.T) {
+	s := Service{logger: logium.NewLogger("error", "text")}
+	userID := uuid.New()
+
+	var entry *accessEntry
+	h := s.RequestID()(s.AccessLog()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		entry, _ = r.Context().Value(accessEntryKey{}).(*accessEntry)
+		if entry != nil {
+			entry.userID = userID
+		}
+		w.WriteHeader(http.StatusTeapot)
+	})))
+
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cities", nil))
+
+	if entry == nil {
+		t.Fatal("no access entry in the request context")
+	}
+	if w.Code != http.StatusTeapot {
+		t.Errorf("status = %d", w.Code)
+	}
+}
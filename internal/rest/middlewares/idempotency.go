@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/go-chi/chi/v5/middleware"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	IdempotencyKeyHeader     = "Idempotency-Key"
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+)
+
+// Idempotency makes requests carrying an Idempotency-Key header safe to retry.
+// The response to the first request with a key is stored and replayed for
+// retries with the same key, method, path and body, reusing the key for a
+// different request is rejected. It has to run after Auth, keys are scoped to
+// the user. Responses with a 5xx status are not stored, so the request can be
+// retried with the same key.
+func (s Service) Idempotency() func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ctx := r.Context()
+
+			user, err := meta.User(ctx)
+			if err != nil {
+				s.log(ctx).WithError(err).Error("failed to get user from context")
+				ape.RenderErr(w, problems.Unauthorized("failed to get user from context"))
+				return
+			}
+
+			body, err := io.ReadAll(r.Body)
+			if err != nil {
+				s.log(ctx).WithError(err).Error("failed to read request body")
+				ape.RenderErr(w, problems.BadRequest(validation.Errors{
+					"body": err,
+				})...)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(body))
+
+			stored, err := s.idempotency.Begin(ctx, user.ID, key, fingerprint(r, body))
+			if err != nil {
+				s.log(ctx).WithError(err).Error("failed to begin idempotent request")
+				switch {
+				case errors.Is(err, errx.ErrorInvalidIdempotencyKey), errors.Is(err, errx.ErrorIdempotencyKeyReused):
+					ape.RenderErr(w, problems.BadRequest(validation.Errors{
+						IdempotencyKeyHeader: err,
+					})...)
+				case errors.Is(err, errx.ErrorIdempotencyKeyInProgress):
+					ape.RenderErr(w, problems.Conflict("request with this idempotency key is in progress"))
+				default:
+					ape.RenderErr(w, problems.InternalError())
+				}
+
+				return
+			}
+
+			if stored.Completed() {
+				if stored.ContentType != "" {
+					w.Header().Set("Content-Type", stored.ContentType)
+				}
+				w.Header().Set(IdempotentReplayedHeader, "true")
+				w.WriteHeader(stored.StatusCode)
+				if _, err = w.Write(stored.Body); err != nil {
+					s.log(ctx).WithError(err).Error("failed to write replayed response")
+				}
+
+				return
+			}
+
+			// The outcome is recorded even if the client is gone by then.
+			storeCtx := context.WithoutCancel(ctx)
+			completed := false
+			defer func() {
+				if !completed {
+					if err := s.idempotency.Release(storeCtx, user.ID, key); err != nil {
+						s.log(ctx).WithError(err).Error("failed to release idempotency key")
+					}
+				}
+			}()
+
+			var res bytes.Buffer
+			ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+			ww.Tee(&res)
+
+			next.ServeHTTP(ww, r)
+
+			status := ww.Status()
+			if status == 0 {
+				status = http.StatusOK
+			}
+			if status >= http.StatusInternalServerError {
+				return
+			}
+
+			err = s.idempotency.Complete(storeCtx, user.ID, key, status, ww.Header().Get("Content-Type"), res.Bytes())
+			if err != nil {
+				s.log(ctx).WithError(err).Error("failed to store idempotent response")
+				return
+			}
+			completed = true
+		})
+	}
+}
+
+// fingerprint identifies the request a key was used for.
+func fingerprint(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.Path + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,82 @@
+package middlewares
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/services/idempotency"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/restkit/token"
+	"github.com/google/uuid"
+)
+
+func TestIdempotency(t *testing.T) {
+	s := Service{
+		logger:      logium.NewLogger("error", "text"),
+		idempotency: idempotency.NewService(memory.New(), time.Hour, time.Minute),
+	}
+
+	calls := 0
+	status := http.StatusCreated
+	h := s.Idempotency()(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(`{"call":` + strconv.Itoa(calls) + `,"body":` + string(body) + `}`))
+	}))
+
+	userID := uuid.New()
+	serve := func(key, body string) *httptest.ResponseRecorder {
+		r := httptest.NewRequest(http.MethodPost, "/cities-svc/v1/cities", strings.NewReader(body))
+		r = r.WithContext(context.WithValue(r.Context(), meta.UserCtxKey, token.UserData{ID: userID}))
+		if key != "" {
+			r.Header.Set(IdempotencyKeyHeader, key)
+		}
+
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve("k1", `{"name":"Kyiv"}`)
+	if w.Code != http.StatusCreated || w.Body.String() != `{"call":1,"body":{"name":"Kyiv"}}` {
+		t.Fatalf("first request: %d %s", w.Code, w.Body.String())
+	}
+
+	w = serve("k1", `{"name":"Kyiv"}`)
+	if calls != 1 {
+		t.Errorf("retry reached the handler, %d calls", calls)
+	}
+	if w.Code != http.StatusCreated || w.Body.String() != `{"call":1,"body":{"name":"Kyiv"}}` ||
+		w.Header().Get("Content-Type") != "application/vnd.api+json" || w.Header().Get(IdempotentReplayedHeader) != "true" {
+		t.Errorf("replay: %d %v %s", w.Code, w.Header(), w.Body.String())
+	}
+
+	if w = serve("k1", `{"name":"Lviv"}`); w.Code != http.StatusBadRequest || calls != 1 {
+		t.Errorf("key reused for another body: %d, %d calls", w.Code, calls)
+	}
+
+	if w = serve("", `{"name":"Kyiv"}`); w.Code != http.StatusCreated || calls != 2 {
+		t.Errorf("without key: %d, %d calls", w.Code, calls)
+	}
+
+	status = http.StatusInternalServerError
+	if w = serve("k2", `{"name":"Odesa"}`); w.Code != http.StatusInternalServerError || calls != 3 {
+		t.Fatalf("failing request: %d, %d calls", w.Code, calls)
+	}
+
+	status = http.StatusCreated
+	if w = serve("k2", `{"name":"Odesa"}`); w.Code != http.StatusCreated || calls != 4 {
+		t.Errorf("retry of a failed request: %d, %d calls", w.Code, calls)
+	}
+}
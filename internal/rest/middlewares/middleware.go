@@ -1,24 +1,76 @@
 package middlewares
 
 import (
+	"context"
 	"net/http"
+	"time"
 
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/requestid"
 	"github.com/chains-lab/logium"
 	"github.com/chains-lab/restkit/mdlv"
+	"github.com/chains-lab/restkit/token"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
 )
 
 type Service struct {
-	log logium.Logger
+	logger      logium.Logger
+	metrics     httpMetrics
+	spec        routers.Router
+	rateLimit   internal.RateLimitConfig
+	idempotency idempotencySvc
 }
 
-func New(log logium.Logger) Service {
+type httpMetrics interface {
+	ObserveHTTPRequest(method, route string, status int, duration time.Duration)
+}
+
+type idempotencySvc interface {
+	Begin(ctx context.Context, userID uuid.UUID, key, fingerprint string) (models.IdempotencyKey, error)
+	Complete(ctx context.Context, userID uuid.UUID, key string, statusCode int, contentType string, body []byte) error
+	Release(ctx context.Context, userID uuid.UUID, key string) error
+}
+
+func New(
+	log logium.Logger,
+	metrics httpMetrics,
+	spec routers.Router,
+	rateLimit internal.RateLimitConfig,
+	idempotency idempotencySvc,
+) Service {
 	return Service{
-		log: log,
+		logger:      log,
+		metrics:     metrics,
+		spec:        spec,
+		rateLimit:   rateLimit,
+		idempotency: idempotency,
 	}
 }
 
+// log returns the logger of the request handled under ctx, tagged with its
+// request id.
+func (s Service) log(ctx context.Context) logrus.FieldLogger {
+	return requestid.Log(ctx, s.logger)
+}
+
+// Auth also records the authenticated user for the access log.
 func (s Service) Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler {
-	return mdlv.Auth(userCtxKey, skUser)
+	auth := mdlv.Auth(userCtxKey, skUser)
+
+	return func(next http.Handler) http.Handler {
+		return auth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if user, ok := r.Context().Value(userCtxKey).(token.UserData); ok {
+				if e, ok := r.Context().Value(accessEntryKey{}).(*accessEntry); ok {
+					e.userID = user.ID
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		}))
+	}
 }
 
 func (s Service) RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler {
@@ -0,0 +1,107 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// ValidateRequest checks path, query params and body of every request against
+// the OpenAPI spec. Requests to routes that are not described in the spec are
+// passed through untouched. Authentication is left to the Auth middleware, it
+// has to run before this one for callers to get 401 rather than 400.
+func (s Service) ValidateRequest() func(http.Handler) http.Handler {
+	opts := &openapi3filter.Options{
+		MultiError:         true,
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, params, err := s.findRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: params,
+				Route:      route,
+				Options:    opts,
+			})
+			if err != nil {
+				s.log(r.Context()).WithError(err).Warn("request does not match openapi spec")
+				ape.RenderErr(w, problems.BadRequest(specErrors(err))...)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// findRoute ignores a trailing slash, chi serves "/cities" and "/cities/" alike.
+func (s Service) findRoute(r *http.Request) (*routers.Route, map[string]string, error) {
+	if s.spec == nil {
+		return nil, nil, routers.ErrPathNotFound
+	}
+
+	if p := r.URL.Path; len(p) > 1 && strings.HasSuffix(p, "/") {
+		rr := r.Clone(r.Context())
+		rr.URL.Path = strings.TrimSuffix(p, "/")
+		rr.URL.RawPath = ""
+		return s.spec.FindRoute(rr)
+	}
+
+	return s.spec.FindRoute(r)
+}
+
+// specErrors converts openapi validation errors to validation.Errors keyed the
+// same way as in the requests package, e.g. "data/attributes/name" for body
+// fields and the parameter name for path and query params.
+func specErrors(err error) validation.Errors {
+	errs := validation.Errors{}
+	collectSpecErrors(errs, "", err)
+
+	return errs
+}
+
+func collectSpecErrors(errs validation.Errors, key string, err error) {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		for _, sub := range e {
+			collectSpecErrors(errs, key, sub)
+		}
+	case *openapi3filter.RequestError:
+		switch {
+		case e.Parameter != nil:
+			key = e.Parameter.Name
+		case e.RequestBody != nil:
+			key = "body"
+		}
+
+		if e.Err == nil {
+			errs[key] = errors.New(e.Reason)
+			return
+		}
+		collectSpecErrors(errs, key, e.Err)
+	case *openapi3.SchemaError:
+		if ptr := e.JSONPointer(); key == "body" && len(ptr) > 0 {
+			key = strings.Join(ptr, "/")
+		}
+		errs[key] = errors.New(e.Reason)
+	default:
+		if key == "" {
+			key = "request"
+		}
+		errs[key] = err
+	}
+}
@@ -0,0 +1,165 @@
+package middlewares
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chains-lab/ape"
+	"github.com/chains-lab/ape/problems"
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+)
+
+// RateLimit limits requests with a token bucket per caller, callers are told
+// apart by the user id set by Auth, or by the client IP on public routes. Every
+// call builds its own buckets, so each policy is counted separately.
+func (s Service) RateLimit(policy internal.RateLimitPolicy) func(http.Handler) http.Handler {
+	if !s.rateLimit.Enabled {
+		return func(next http.Handler) http.Handler { return next }
+	}
+
+	l := newLimiter(policy, time.Now)
+	header := fmt.Sprintf("%d;w=%d;burst=%d", policy.Requests, int(policy.Per.Seconds()), l.burst)
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			q := l.take(s.callerKey(r))
+
+			h := w.Header()
+			h.Set("RateLimit-Policy", header)
+			h.Set("RateLimit-Limit", strconv.Itoa(l.burst))
+			h.Set("RateLimit-Remaining", strconv.Itoa(q.remaining))
+			h.Set("RateLimit-Reset", strconv.Itoa(seconds(q.reset)))
+
+			if !q.ok {
+				h.Set("Retry-After", strconv.Itoa(seconds(q.retryAfter)))
+				ape.RenderErr(w, problems.TooManyRequests("too many requests, retry later"))
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func (s Service) callerKey(r *http.Request) string {
+	if user, err := meta.User(r.Context()); err == nil {
+		return "user:" + user.ID.String()
+	}
+
+	return "ip:" + clientIP(r, s.rateLimit.TrustProxy)
+}
+
+func clientIP(r *http.Request, trustProxy bool) string {
+	if trustProxy {
+		if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+			ip, _, _ := strings.Cut(xff, ",")
+			return strings.TrimSpace(ip)
+		}
+	}
+
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+
+	return host
+}
+
+func seconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
+
+type bucket struct {
+	tokens  float64
+	updated time.Time
+}
+
+// limiter keeps a token bucket per key. A bucket gains rate tokens per second
+// up to burst, buckets that refilled completely are forgotten.
+type limiter struct {
+	mu      sync.Mutex
+	now     func() time.Time
+	rate    float64
+	burst   int
+	buckets map[string]*bucket
+	swept   time.Time
+}
+
+func newLimiter(policy internal.RateLimitPolicy, now func() time.Time) *limiter {
+	burst := policy.Burst
+	if burst == 0 {
+		burst = policy.Requests
+	}
+
+	return &limiter{
+		now:     now,
+		rate:    float64(policy.Requests) / policy.Per.Seconds(),
+		burst:   burst,
+		buckets: make(map[string]*bucket),
+		swept:   now(),
+	}
+}
+
+// quota is the state of a bucket after a request, reset is the time until
+// the bucket is full again.
+type quota struct {
+	ok         bool
+	remaining  int
+	reset      time.Duration
+	retryAfter time.Duration
+}
+
+// take spends a token of the key's bucket.
+func (l *limiter) take(key string) quota {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	b, found := l.buckets[key]
+	if !found {
+		b = &bucket{tokens: float64(l.burst), updated: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(float64(l.burst), b.tokens+now.Sub(b.updated).Seconds()*l.rate)
+	b.updated = now
+
+	q := quota{ok: b.tokens >= 1}
+	if q.ok {
+		b.tokens--
+	} else {
+		q.retryAfter = l.refill(1 - b.tokens)
+	}
+	q.remaining = int(b.tokens)
+	q.reset = l.refill(float64(l.burst) - b.tokens)
+
+	return q
+}
+
+func (l *limiter) refill(tokens float64) time.Duration {
+	return time.Duration(tokens / l.rate * float64(time.Second))
+}
+
+// sweep drops the buckets which are full by now, at most once per refill period.
+func (l *limiter) sweep(now time.Time) {
+	full := l.refill(float64(l.burst))
+	if now.Sub(l.swept) < full {
+		return
+	}
+	l.swept = now
+
+	for key, b := range l.buckets {
+		if now.Sub(b.updated) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package middlewares
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/restkit/token"
+	"github.com/google/uuid"
+)
+
+func TestLimiterTake(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	l := newLimiter(internal.RateLimitPolicy{Requests: 60, Per: time.Minute, Burst: 3}, func() time.Time { return now })
+
+	for i := 2; i >= 0; i-- {
+		q := l.take("a")
+		if !q.ok || q.remaining != i {
+			t.Fatalf("take = %+v, want ok with %d remaining", q, i)
+		}
+	}
+
+	q := l.take("a")
+	if q.ok || q.retryAfter != time.Second || q.reset != 3*time.Second {
+		t.Errorf("take on drained bucket = %+v", q)
+	}
+	if q := l.take("b"); !q.ok {
+		t.Error("buckets are not separate per key")
+	}
+
+	now = now.Add(1500 * time.Millisecond)
+	if q := l.take("a"); !q.ok || q.remaining != 0 {
+		t.Errorf("take after refill = %+v", q)
+	}
+	if q := l.take("a"); q.ok || q.retryAfter != 500*time.Millisecond {
+		t.Errorf("take on partial token = %+v", q)
+	}
+
+	now = now.Add(time.Hour)
+	l.take("c")
+	if _, ok := l.buckets["a"]; ok {
+		t.Error("full bucket is not swept")
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	s := Service{rateLimit: internal.RateLimitConfig{Enabled: true, TrustProxy: true}}
+	h := s.RateLimit(internal.RateLimitPolicy{Requests: 10, Per: time.Minute, Burst: 1})(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNoContent)
+		}),
+	)
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	w := serve(httptest.NewRequest(http.MethodGet, "/cities", nil))
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("status = %d", w.Code)
+	}
+	for key, want := range map[string]string{
+		"RateLimit-Policy":    "10;w=60;burst=1",
+		"RateLimit-Limit":     "1",
+		"RateLimit-Remaining": "0",
+		"RateLimit-Reset":     "6",
+	} {
+		if got := w.Header().Get(key); got != want {
+			t.Errorf("%s = %q, want %q", key, got, want)
+		}
+	}
+
+	w = serve(httptest.NewRequest(http.MethodGet, "/cities", nil))
+	if w.Code != http.StatusTooManyRequests {
+		t.Fatalf("status = %d, want 429", w.Code)
+	}
+	if got := w.Header().Get("Retry-After"); got != "6" {
+		t.Errorf("Retry-After = %q", got)
+	}
+	if !strings.Contains(w.Body.String(), `"status":"429"`) {
+		t.Errorf("body = %s", w.Body.String())
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/cities", nil)
+	r.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+	if w = serve(r); w.Code != http.StatusNoContent {
+		t.Errorf("other client ip: status = %d", w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/cities", nil)
+	r = r.WithContext(context.WithValue(r.Context(), meta.UserCtxKey, token.UserData{ID: uuid.New()}))
+	if w = serve(r); w.Code != http.StatusNoContent {
+		t.Errorf("authenticated user: status = %d", w.Code)
+	}
+}
+
+func TestRateLimitDisabled(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	h := Service{}.RateLimit(internal.RateLimitPolicy{Requests: 1, Per: time.Minute})(next)
+	for range 3 {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/cities", nil))
+		if w.Code != http.StatusOK || w.Header().Get("RateLimit-Limit") != "" {
+			t.Fatalf("status = %d, headers = %v", w.Code, w.Header())
+		}
+	}
+}
@@ -0,0 +1,24 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/chains-lab/cities-svc/resources"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const MaxBatchSize = 100
+
+func BatchGetCities(r *http.Request) (req resources.BatchGetCities, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type":           validation.Validate(req.Data.Type, validation.Required, validation.In(resources.CitiesBatchType)),
+		"data/attributes/ids": validation.Validate(req.Data.Attributes.Ids, validation.Required, validation.Length(1, MaxBatchSize)),
+	}
+	return req, errs.Filter()
+}
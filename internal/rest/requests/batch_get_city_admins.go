@@ -0,0 +1,29 @@
+package requests
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+
+	"github.com/chains-lab/cities-svc/resources"
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var cityAdminIDRegexp = regexp.MustCompile(`^[0-9a-fA-F-]{36}:[0-9a-fA-F-]{36}$`)
+
+func BatchGetCityAdmins(r *http.Request) (req resources.BatchGetCityAdmins, err error) {
+	if err = json.NewDecoder(r.Body).Decode(&req); err != nil {
+		err = newDecodeError("body", err)
+		return
+	}
+
+	errs := validation.Errors{
+		"data/type": validation.Validate(req.Data.Type, validation.Required, validation.In(resources.CityAdminsBatchType)),
+		"data/attributes/ids": validation.Validate(req.Data.Attributes.Ids,
+			validation.Required,
+			validation.Length(1, MaxBatchSize),
+			validation.Each(validation.Match(cityAdminIDRegexp).Error("must be user_id:city_id")),
+		),
+	}
+	return req, errs.Filter()
+}
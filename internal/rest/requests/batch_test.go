@@ -0,0 +1,77 @@
+package requests
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/resources"
+	"github.com/google/uuid"
+)
+
+func batchRequest(t *testing.T, typ string, ids []string) *http.Request {
+	t.Helper()
+
+	body, err := json.Marshal(map[string]any{
+		"data": map[string]any{
+			"type":       typ,
+			"attributes": map[string]any{"ids": ids},
+		},
+	})
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+
+	return httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+}
+
+func TestBatchSizeLimit(t *testing.T) {
+	cityIDs := func(n int) []string {
+		ids := make([]string, n)
+		for i := range ids {
+			ids[i] = uuid.NewString()
+		}
+		return ids
+	}
+	adminIDs := func(n int) []string {
+		ids := make([]string, n)
+		for i := range ids {
+			ids[i] = uuid.NewString() + ":" + uuid.NewString()
+		}
+		return ids
+	}
+
+	for _, tc := range []struct {
+		name  string
+		parse func(r *http.Request) error
+		typ   string
+		ids   func(n int) []string
+	}{
+		{
+			name:  "cities",
+			parse: func(r *http.Request) error { _, err := BatchGetCities(r); return err },
+			typ:   resources.CitiesBatchType,
+			ids:   cityIDs,
+		},
+		{
+			name:  "city admins",
+			parse: func(r *http.Request) error { _, err := BatchGetCityAdmins(r); return err },
+			typ:   resources.CityAdminsBatchType,
+			ids:   adminIDs,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := tc.parse(batchRequest(t, tc.typ, tc.ids(MaxBatchSize))); err != nil {
+				t.Errorf("%d ids: %v", MaxBatchSize, err)
+			}
+			if err := tc.parse(batchRequest(t, tc.typ, tc.ids(MaxBatchSize+1))); err == nil {
+				t.Errorf("%d ids accepted", MaxBatchSize+1)
+			}
+			if err := tc.parse(batchRequest(t, tc.typ, nil)); err == nil {
+				t.Error("no ids accepted")
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package responses
+
+import (
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/resources"
+	"github.com/google/uuid"
+)
+
+func AdminMemberships(
+	userID uuid.UUID,
+	admins []models.CityAdmin,
+	invites []models.Invite,
+	cities []models.City,
+) resources.AdminMemberships {
+	byID := make(map[uuid.UUID]models.City, len(cities))
+	for _, c := range cities {
+		byID[c.ID] = c
+	}
+
+	resp := resources.AdminMemberships{
+		Data: resources.AdminMembershipsData{
+			Id:   userID,
+			Type: resources.AdminMembershipsType,
+			Attributes: resources.AdminMembershipsDataAttributes{
+				Memberships: make([]resources.AdminMembership, 0, len(admins)),
+				Invites:     make([]resources.PendingInvite, 0, len(invites)),
+			},
+		},
+	}
+
+	for _, a := range admins {
+		c, ok := byID[a.CityID]
+		if !ok {
+			continue
+		}
+
+		resp.Data.Attributes.Memberships = append(resp.Data.Attributes.Memberships, resources.AdminMembership{
+			City:        City(c).Data,
+			Admin:       CityAdmin(a).Data,
+			Permissions: enum.GetCityAdminPermissions(a.Role),
+		})
+	}
+
+	for _, inv := range invites {
+		c, ok := byID[inv.CityID]
+		if !ok {
+			continue
+		}
+
+		resp.Data.Attributes.Invites = append(resp.Data.Attributes.Invites, resources.PendingInvite{
+			Invite: Invite(inv).Data,
+			City:   City(c).Data,
+		})
+	}
+
+	return resp
+}
@@ -3,6 +3,7 @@ package responses
 import (
 	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/resources"
+	"github.com/google/uuid"
 )
 
 func City(m models.City) resources.City {
@@ -53,3 +54,18 @@ func CitiesCollection(ms models.CitiesCollection) resources.CitiesCollection {
 
 	return resp
 }
+
+func CitiesBatch(ms []models.City, notFound []uuid.UUID) resources.CitiesBatch {
+	resp := resources.CitiesBatch{
+		Data: make([]resources.CityData, 0, len(ms)),
+		Meta: resources.CitiesBatchMeta{
+			NotFound: notFound,
+		},
+	}
+
+	for _, m := range ms {
+		resp.Data = append(resp.Data, City(m).Data)
+	}
+
+	return resp
+}
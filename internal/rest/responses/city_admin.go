@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
 	"github.com/chains-lab/cities-svc/resources"
 )
 
@@ -41,3 +42,21 @@ func CityAdminsCollection(ms models.CityAdminsCollection) resources.CityAdminsCo
 
 	return resp
 }
+
+func CityAdminsBatch(ms []models.CityAdmin, notFound []admin.Key) resources.CityAdminsBatch {
+	resp := resources.CityAdminsBatch{
+		Data: make([]resources.CityAdminData, 0, len(ms)),
+		Meta: resources.CityAdminsBatchMeta{
+			NotFound: make([]string, 0, len(notFound)),
+		},
+	}
+
+	for _, m := range ms {
+		resp.Data = append(resp.Data, CityAdmin(m).Data)
+	}
+	for _, k := range notFound {
+		resp.Meta.NotFound = append(resp.Meta.NotFound, fmt.Sprintf("%s:%s", k.UserID, k.CityID))
+	}
+
+	return resp
+}
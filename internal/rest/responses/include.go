@@ -0,0 +1,100 @@
+package responses
+
+import (
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/chains-lab/cities-svc/internal/rest/jsonapi"
+	"github.com/chains-lab/cities-svc/resources"
+	"github.com/google/uuid"
+)
+
+const (
+	IncludeCity   = "city"
+	IncludeAdmins = "admins"
+	IncludeUser   = "user"
+)
+
+func cityIdentifier(m models.City) jsonapi.Identifier {
+	return jsonapi.Identifier{Type: resources.CityType, ID: m.ID.String()}
+}
+
+func inviteIdentifier(m models.Invite) jsonapi.Identifier {
+	return jsonapi.Identifier{Type: resources.InviteType, ID: m.ID.String()}
+}
+
+func profileIdentifier(p profiles.Profile) jsonapi.Identifier {
+	return jsonapi.Identifier{Type: resources.ProfileType, ID: p.UserID.String()}
+}
+
+func cityAdminIdentifier(m models.CityAdmin) jsonapi.Identifier {
+	return jsonapi.Identifier{Type: resources.CityAdminType, ID: CityAdmin(m).Data.Id}
+}
+
+// IncludeCityAdmins links every city with its admins and includes the admins.
+func IncludeCityAdmins(doc *jsonapi.Document, cities []models.City, admins []models.CityAdmin) {
+	byCity := make(map[uuid.UUID][]jsonapi.Identifier, len(cities))
+	for _, a := range admins {
+		byCity[a.CityID] = append(byCity[a.CityID], cityAdminIdentifier(a))
+		doc.Include(CityAdmin(a).Data)
+	}
+
+	for _, c := range cities {
+		doc.RelateMany(cityIdentifier(c), IncludeAdmins, byCity[c.ID]...)
+	}
+}
+
+// IncludeAdminCities links every admin with its city and includes the cities.
+func IncludeAdminCities(doc *jsonapi.Document, admins []models.CityAdmin, cities []models.City) {
+	byID := make(map[uuid.UUID]models.City, len(cities))
+	for _, c := range cities {
+		byID[c.ID] = c
+		doc.Include(City(c).Data)
+	}
+
+	for _, a := range admins {
+		if c, ok := byID[a.CityID]; ok {
+			doc.RelateOne(cityAdminIdentifier(a), IncludeCity, cityIdentifier(c))
+		}
+	}
+}
+
+// IncludeInviteCities links every invite with its city and includes the cities.
+func IncludeInviteCities(doc *jsonapi.Document, invites []models.Invite, cities []models.City) {
+	byID := make(map[uuid.UUID]models.City, len(cities))
+	for _, c := range cities {
+		byID[c.ID] = c
+		doc.Include(City(c).Data)
+	}
+
+	for _, inv := range invites {
+		if c, ok := byID[inv.CityID]; ok {
+			doc.RelateOne(inviteIdentifier(inv), IncludeCity, cityIdentifier(c))
+		}
+	}
+}
+
+// IncludeAdminUsers links every admin with the user profile and includes the profiles.
+func IncludeAdminUsers(doc *jsonapi.Document, admins []models.CityAdmin, users map[uuid.UUID]profiles.Profile) {
+	for _, a := range admins {
+		user, ok := users[a.UserID]
+		if !ok {
+			continue
+		}
+
+		doc.Include(Profile(user).Data)
+		doc.RelateOne(cityAdminIdentifier(a), IncludeUser, profileIdentifier(user))
+	}
+}
+
+// IncludeInviteUsers links every invite with the invited user profile and includes the profiles.
+func IncludeInviteUsers(doc *jsonapi.Document, invites []models.Invite, users map[uuid.UUID]profiles.Profile) {
+	for _, inv := range invites {
+		user, ok := users[inv.UserID]
+		if !ok {
+			continue
+		}
+
+		doc.Include(Profile(user).Data)
+		doc.RelateOne(inviteIdentifier(inv), IncludeUser, profileIdentifier(user))
+	}
+}
@@ -24,3 +24,20 @@ func Invite(m models.Invite) resources.Invite {
 
 	return resp
 }
+
+func InvitesCollection(ms models.InvitesCollection) resources.InvitesCollection {
+	resp := resources.InvitesCollection{
+		Data: make([]resources.InviteData, 0, len(ms.Data)),
+		Links: resources.PaginationData{
+			PageNumber: int64(ms.Page),
+			PageSize:   int64(ms.Size),
+			TotalItems: int64(ms.Total),
+		},
+	}
+
+	for _, m := range ms.Data {
+		resp.Data = append(resp.Data, Invite(m).Data)
+	}
+
+	return resp
+}
@@ -0,0 +1,19 @@
+package responses
+
+import (
+	"github.com/chains-lab/cities-svc/internal/profiles"
+	"github.com/chains-lab/cities-svc/resources"
+)
+
+func Profile(p profiles.Profile) resources.Profile {
+	return resources.Profile{
+		Data: resources.ProfileData{
+			Id:   p.UserID,
+			Type: resources.ProfileType,
+			Attributes: resources.ProfileAttributes{
+				Username: p.Username,
+				Avatar:   p.Avatar,
+			},
+		},
+	}
+}
@@ -17,69 +17,116 @@ type Handlers interface {
 	ListCities(w http.ResponseWriter, r *http.Request)
 	CreateCity(w http.ResponseWriter, r *http.Request)
 	GetCity(w http.ResponseWriter, r *http.Request)
+	BatchGetCities(w http.ResponseWriter, r *http.Request)
 	UpdateCity(w http.ResponseWriter, r *http.Request)
 	UpdateCityStatus(w http.ResponseWriter, r *http.Request)
 
 	GetCityBySlug(w http.ResponseWriter, r *http.Request)
 	ListAdmins(w http.ResponseWriter, r *http.Request)
-	SentInvite(w http.ResponseWriter, r *http.Request)
-	ReplyInvite(w http.ResponseWriter, r *http.Request)
+	CreateInvite(w http.ResponseWriter, r *http.Request)
+	AcceptInvite(w http.ResponseWriter, r *http.Request)
+	DeclineInvite(w http.ResponseWriter, r *http.Request)
+	ListCityInvites(w http.ResponseWriter, r *http.Request)
+	StreamCityEvents(w http.ResponseWriter, r *http.Request)
 	GetCityAdmin(w http.ResponseWriter, r *http.Request)
+	BatchGetCityAdmins(w http.ResponseWriter, r *http.Request)
 	DeleteCityAdmin(w http.ResponseWriter, r *http.Request)
 
 	GetMyCityAdmin(w http.ResponseWriter, r *http.Request)
 	UpdateCityAdmin(w http.ResponseWriter, r *http.Request)
 	UpdateMyCityAdmin(w http.ResponseWriter, r *http.Request)
 	RefuseMyCityAdmin(w http.ResponseWriter, r *http.Request)
+	GetMyAdminMemberships(w http.ResponseWriter, r *http.Request)
 }
 
 type Middlewares interface {
+	RequestID() func(http.Handler) http.Handler
+	AccessLog() func(http.Handler) http.Handler
 	Auth(userCtxKey interface{}, skUser string) func(http.Handler) http.Handler
 	RoleGrant(userCtxKey interface{}, allowedRoles map[string]bool) func(http.Handler) http.Handler
+	Metrics() func(http.Handler) http.Handler
+	Tracing() func(http.Handler) http.Handler
+	ValidateRequest() func(http.Handler) http.Handler
+	RateLimit(policy internal.RateLimitPolicy) func(http.Handler) http.Handler
+	Idempotency() func(http.Handler) http.Handler
 }
 
-func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewares, h Handlers) {
+type Probes interface {
+	Liveness(w http.ResponseWriter, r *http.Request)
+	Readiness(w http.ResponseWriter, r *http.Request)
+	SetNotReady()
+}
+
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewares, h Handlers, p Probes) {
 	auth := m.Auth(meta.UserCtxKey, cfg.JWT.User.AccessToken.SecretKey)
 
 	sysadmin := m.RoleGrant(meta.UserCtxKey, map[string]bool{
 		roles.SystemAdmin: true,
 	})
 
-	r := chi.NewRouter()
+	// Limits go after auth, so authenticated callers are limited per user
+	// rather than per IP. Requests are checked against the spec after the
+	// limits, an unauthenticated malformed request gets 401 rather than 400.
+	validate := m.ValidateRequest()
+	limit := func(policy internal.RateLimitPolicy) func(http.Handler) http.Handler {
+		rl := m.RateLimit(policy)
+		return func(next http.Handler) http.Handler {
+			return rl(validate(next))
+		}
+	}
 
-	r.Route("/cities-svc/", func(r chi.Router) {
-		r.Route("/v1", func(r chi.Router) {
-			r.Get("/city/{slug}", h.GetCityBySlug)
+	reads := limit(cfg.RateLimit.Reads)
+	writes := limit(cfg.RateLimit.Writes)
+	invites := limit(cfg.RateLimit.Invites)
+	idempotent := m.Idempotency()
 
-			r.Route("/cities", func(r chi.Router) {
-				r.Get("/", h.ListCities)
+	r := chi.NewRouter()
+	r.Get("/healthz", p.Liveness)
+	r.Get("/readyz", p.Readiness)
+
+	r.Group(func(r chi.Router) {
+		r.Use(m.RequestID(), m.AccessLog(), m.Tracing(), m.Metrics())
+
+		r.Route("/cities-svc/", func(r chi.Router) {
+			r.Route("/v1", func(r chi.Router) {
+				r.With(reads).Get("/city/{slug}", h.GetCityBySlug)
+				r.With(reads).Post("/admins/batch", h.BatchGetCityAdmins)
+				r.With(auth, reads).Get("/me/admin-memberships", h.GetMyAdminMemberships)
+
+				r.With(auth, invites, idempotent).Route("/invites", func(r chi.Router) {
+					r.Post("/", h.CreateInvite)
+					r.Post("/{invite_id}/accept", h.AcceptInvite)
+					r.Post("/{invite_id}/decline", h.DeclineInvite)
+				})
 
-				r.With(auth, sysadmin).Post("/", h.CreateCity)
+				r.Route("/cities", func(r chi.Router) {
+					r.With(reads).Get("/", h.ListCities)
 
-				r.Route("/{city_id}", func(r chi.Router) {
-					r.Get("/", h.GetCity)
+					r.With(auth, sysadmin, writes, idempotent).Post("/", h.CreateCity)
+					r.With(reads).Post("/batch", h.BatchGetCities)
 
-					r.With(auth).Put("/", h.UpdateCity)
-					r.With(auth, sysadmin).Patch("/status", h.UpdateCityStatus)
+					r.Route("/{city_id}", func(r chi.Router) {
+						r.With(reads).Get("/", h.GetCity)
 
-					r.Route("/admins", func(r chi.Router) {
-						r.Get("/", h.ListAdmins)
+						r.With(auth, writes).Put("/", h.UpdateCity)
+						r.With(auth, writes).Patch("/status", h.UpdateCityStatus)
+						r.With(auth, reads).Get("/invites", h.ListCityInvites)
+						r.With(auth, reads).Get("/events", h.StreamCityEvents)
 
-						r.With(auth).Route("/invite", func(r chi.Router) {
-							r.Post("/", h.SentInvite)
-							r.Post("/", h.ReplyInvite)
-						})
+						r.Route("/admins", func(r chi.Router) {
+							r.With(reads).Get("/", h.ListAdmins)
 
-						r.With(auth).Route("/me", func(r chi.Router) {
-							r.Get("/", h.GetMyCityAdmin)
-							r.Put("/", h.UpdateMyCityAdmin)
-							r.Delete("/", h.RefuseMyCityAdmin)
-						})
+							r.With(auth).Route("/me", func(r chi.Router) {
+								r.With(reads).Get("/", h.GetMyCityAdmin)
+								r.With(writes).Put("/", h.UpdateMyCityAdmin)
+								r.With(writes).Delete("/", h.RefuseMyCityAdmin)
+							})
 
-						r.Route("/{user_id}", func(r chi.Router) {
-							r.Get("/", h.GetCityAdmin)
-							r.With(auth).Put("/", h.UpdateCityAdmin)
-							r.With(auth).Delete("/", h.DeleteCityAdmin)
+							r.Route("/{user_id}", func(r chi.Router) {
+								r.With(reads).Get("/", h.GetCityAdmin)
+								r.With(auth, writes).Put("/", h.UpdateCityAdmin)
+								r.With(auth, writes).Delete("/", h.DeleteCityAdmin)
+							})
 						})
 					})
 				})
@@ -109,6 +156,11 @@ func Run(ctx context.Context, cfg internal.Config, log logium.Logger, m Middlewa
 
 	select {
 	case <-ctx.Done():
+		p.SetNotReady()
+		if d := cfg.Health.ShutdownDelay; d > 0 {
+			log.Infof("REST service is not ready, waiting %s before shutdown", d)
+			time.Sleep(d)
+		}
 		log.Info("shutting down REST service...")
 	case err := <-errCh:
 		if err != nil {
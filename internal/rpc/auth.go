@@ -0,0 +1,75 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/restkit/token"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Auth verifies the user access token passed as "authorization: Bearer <jwt>"
+// metadata with auth, the restkit middleware the REST API uses, so both
+// transports accept exactly the same tokens. The user is put into the context
+// under meta.UserCtxKey, auth has to store it there too.
+func Auth(auth func(http.Handler) http.Handler) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		user, err := authenticate(ctx, auth)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, err.Error())
+		}
+
+		return handler(context.WithValue(ctx, meta.UserCtxKey, user), req)
+	}
+}
+
+func authenticate(ctx context.Context, auth func(http.Handler) http.Handler) (token.UserData, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return token.UserData{}, fmt.Errorf("missing authorization metadata")
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	if err != nil {
+		return token.UserData{}, fmt.Errorf("build auth request: %w", err)
+	}
+	r.Header.Set("Authorization", values[0])
+
+	var (
+		user   token.UserData
+		userOK bool
+	)
+	w := &discardWriter{header: http.Header{}}
+	auth(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+		user, err = meta.User(r.Context())
+		userOK = err == nil
+	})).ServeHTTP(w, r)
+
+	if !userOK {
+		return token.UserData{}, fmt.Errorf("invalid access token")
+	}
+
+	return user, nil
+}
+
+// discardWriter swallows the response auth renders for a rejected token.
+type discardWriter struct {
+	header http.Header
+}
+
+func (w *discardWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *discardWriter) Write(b []byte) (int, error) {
+	return len(b), nil
+}
+
+func (w *discardWriter) WriteHeader(int) {}
@@ -0,0 +1,63 @@
+package rpc
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/chains-lab/restkit/token"
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuth stands in for the restkit middleware, it accepts one token.
+func fakeAuth(valid string, user token.UserData) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer "+valid {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), meta.UserCtxKey, user)))
+		})
+	}
+}
+
+func TestAuth(t *testing.T) {
+	user := token.UserData{ID: uuid.New(), Role: roles.SystemUser, Session: uuid.New(), Verified: true}
+	interceptor := Auth(fakeAuth("good", user))
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return meta.User(ctx)
+	}
+
+	for _, tc := range []struct {
+		name string
+		md   metadata.MD
+		code codes.Code
+	}{
+		{name: "valid token", md: metadata.Pairs("authorization", "Bearer good"), code: codes.OK},
+		{name: "invalid token", md: metadata.Pairs("authorization", "Bearer bad"), code: codes.Unauthenticated},
+		{name: "no metadata", md: nil, code: codes.Unauthenticated},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			if code := status.Code(err); code != tc.code {
+				t.Fatalf("code = %s, want %s (%v)", code, tc.code, err)
+			}
+			if tc.code == codes.OK && res.(token.UserData) != user {
+				t.Errorf("user = %+v, want %+v", res, user)
+			}
+		})
+	}
+}
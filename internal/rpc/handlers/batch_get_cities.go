@@ -0,0 +1,48 @@
+package handlers
+
+import (
+	"context"
+
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const maxBatchSize = 100
+
+func (s Service) BatchGetCities(ctx context.Context, req *citiesv1.BatchGetCitiesRequest) (*citiesv1.BatchGetCitiesResponse, error) {
+	if len(req.GetIds()) == 0 {
+		return &citiesv1.BatchGetCitiesResponse{}, nil
+	}
+	if len(req.GetIds()) > maxBatchSize {
+		return nil, status.Errorf(codes.InvalidArgument, "too many ids, max %d", maxBatchSize)
+	}
+
+	ids := make([]uuid.UUID, 0, len(req.GetIds()))
+	for _, raw := range req.GetIds() {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid id %q: %v", raw, err)
+		}
+		ids = append(ids, id)
+	}
+
+	cities, notFound, err := s.domain.city.GetByIDs(ctx, ids)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to batch get cities")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	resp := &citiesv1.BatchGetCitiesResponse{
+		Cities: make([]*citiesv1.City, 0, len(cities)),
+	}
+	for _, c := range cities {
+		resp.Cities = append(resp.Cities, cityResponse(c))
+	}
+	for _, id := range notFound {
+		resp.NotFound = append(resp.NotFound, id.String())
+	}
+
+	return resp, nil
+}
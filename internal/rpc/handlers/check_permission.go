@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) CheckPermission(ctx context.Context, req *citiesv1.CheckPermissionRequest) (*citiesv1.CheckPermissionResponse, error) {
+	cityID, err := uuid.Parse(req.GetCityId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid city_id: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	for _, role := range req.GetRoles() {
+		if err = enum.CheckCityAdminRole(role); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	if _, err = s.domain.city.GetByID(ctx, cityID); err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorCityNotFound):
+			return nil, status.Error(codes.NotFound, "city not found")
+		default:
+			s.log(ctx).WithError(err).Error("failed to get city to check permission")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	res, err := s.domain.admin.Get(ctx, userID, cityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorCityAdminNotFound):
+			return &citiesv1.CheckPermissionResponse{Allowed: false}, nil
+		default:
+			s.log(ctx).WithError(err).Error("failed to check city admin permission")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	// a membership of another city never grants anything here
+	if res.CityID != cityID {
+		return &citiesv1.CheckPermissionResponse{Allowed: false}, nil
+	}
+
+	return &citiesv1.CheckPermissionResponse{
+		Allowed: len(req.GetRoles()) == 0 || slices.Contains(req.GetRoles(), res.Role),
+		Role:    res.Role,
+	}, nil
+}
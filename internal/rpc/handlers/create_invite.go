@@ -0,0 +1,75 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// inviteDuration is how long an invite can be answered, the same as over REST.
+const inviteDuration = 24 * time.Hour
+
+func (s Service) CreateInvite(ctx context.Context, req *citiesv1.CreateInviteRequest) (*citiesv1.Invite, error) {
+	initiator, err := meta.User(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	cityID, err := uuid.Parse(req.GetCityId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid city_id: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	params := invite.CreateParams{
+		UserID:   userID,
+		CityID:   cityID,
+		Role:     req.GetRole(),
+		Duration: inviteDuration,
+	}
+
+	var res models.Invite
+	switch initiator.Role {
+	case roles.SystemUser:
+		res, err = s.domain.invite.CreateByCityAdmin(ctx, initiator.ID, params)
+	case roles.SystemAdmin:
+		res, err = s.domain.invite.CreateBySysAdmin(ctx, initiator.ID, params)
+	default:
+		return nil, status.Error(codes.PermissionDenied, "not enough rights to create invite")
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorNotEnoughRight):
+			return nil, status.Error(codes.PermissionDenied, "not enough rights to create invite")
+		case errors.Is(err, errx.ErrorInvalidCityAdminRole):
+			return nil, status.Error(codes.InvalidArgument, "invalid role")
+		case errors.Is(err, errx.ErrorCityNotFound):
+			return nil, status.Error(codes.NotFound, "city not found")
+		case errors.Is(err, errx.ErrorInviteeNotFound):
+			return nil, status.Error(codes.NotFound, "invited user not found")
+		case errors.Is(err, errx.ErrorCityAdminAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "city admin already exists")
+		case errors.Is(err, errx.ErrorCityIsNotSupported):
+			return nil, status.Error(codes.FailedPrecondition, "city is not supported")
+		default:
+			s.log(ctx).WithError(err).Error("failed to create invite")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return inviteResponse(res), nil
+}
@@ -0,0 +1,37 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) GetCityAdmin(ctx context.Context, req *citiesv1.GetCityAdminRequest) (*citiesv1.CityAdmin, error) {
+	cityID, err := uuid.Parse(req.GetCityId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid city_id: %v", err)
+	}
+
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	res, err := s.domain.admin.Get(ctx, userID, cityID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorCityAdminNotFound):
+			return nil, status.Error(codes.NotFound, "city admin not found")
+		default:
+			s.log(ctx).WithError(err).Error("failed to get city admin")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return cityAdminResponse(res), nil
+}
@@ -0,0 +1,32 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) GetInvite(ctx context.Context, req *citiesv1.GetInviteRequest) (*citiesv1.Invite, error) {
+	inviteID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	res, err := s.domain.invite.Get(ctx, inviteID)
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorInviteNotFound):
+			return nil, status.Error(codes.NotFound, "invite not found")
+		default:
+			s.log(ctx).WithError(err).Error("failed to get invite")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return inviteResponse(res), nil
+}
@@ -0,0 +1,342 @@
+package handlers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/restkit/roles"
+	"github.com/chains-lab/restkit/token"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type setup struct {
+	db  *memory.DB
+	svc Service
+}
+
+// noEvents drops the events published by invites.
+type noEvents struct{}
+
+func (noEvents) PublishInviteCreated(context.Context, models.Invite, models.City, ...uuid.UUID) error {
+	return nil
+}
+
+func (noEvents) PublishInviteAccepted(context.Context, models.Invite, models.City, models.CityAdmin, ...uuid.UUID) error {
+	return nil
+}
+
+func (noEvents) PublishInviteDeclined(context.Context, models.Invite, models.City, ...uuid.UUID) error {
+	return nil
+}
+
+func (noEvents) PublishCityAdminCreated(context.Context, models.CityAdmin, models.City, ...uuid.UUID) error {
+	return nil
+}
+
+// everyone knows every user.
+type everyone struct{}
+
+func (everyone) UserExists(context.Context, uuid.UUID) (bool, error) { return true, nil }
+
+// newSetup serves handlers from the in-memory repo, the city and admin lookups
+// never publish events.
+func newSetup() setup {
+	db := memory.New()
+
+	return setup{
+		db: db,
+		svc: New(
+			logium.NewLogger("error", "text"),
+			city.NewService(db, nil),
+			admin.NewService(db, nil),
+			invite.NewService(db, noEvents{}, everyone{}),
+		),
+	}
+}
+
+// as returns a context authenticated as the user with the system role.
+func as(userID uuid.UUID, role string) context.Context {
+	return context.WithValue(context.Background(), meta.UserCtxKey, token.UserData{ID: userID, Role: role})
+}
+
+func (s setup) createCity(t *testing.T, name string, point orb.Point) models.City {
+	t.Helper()
+
+	now := time.Now().UTC()
+	c, err := s.db.CreateCity(context.Background(), models.City{
+		ID:        uuid.New(),
+		CountryID: "UKR",
+		Point:     point,
+		Status:    enum.CityStatusSupported,
+		Name:      name,
+		Timezone:  "Europe/Kyiv",
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		t.Fatalf("create city %s: %v", name, err)
+	}
+
+	return c
+}
+
+func (s setup) createAdmin(t *testing.T, cityID uuid.UUID, role string) models.CityAdmin {
+	t.Helper()
+
+	now := time.Now().UTC()
+	a := models.CityAdmin{UserID: uuid.New(), CityID: cityID, Role: role, CreatedAt: now, UpdatedAt: now}
+	if err := s.db.CreateCityAdmin(context.Background(), a); err != nil {
+		t.Fatalf("create %s: %v", role, err)
+	}
+
+	return a
+}
+
+func TestGetCity(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+
+	res, err := s.svc.GetCity(context.Background(), &citiesv1.GetCityRequest{Id: kyiv.ID.String()})
+	if err != nil {
+		t.Fatalf("GetCity: %v", err)
+	}
+	if res.GetId() != kyiv.ID.String() || res.GetName() != "Kyiv" || res.GetPoint().GetLatitude() != 50.4501 {
+		t.Errorf("city = %+v", res)
+	}
+
+	_, err = s.svc.GetCity(context.Background(), &citiesv1.GetCityRequest{Id: uuid.NewString()})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("missing city: code = %s, want %s", code, codes.NotFound)
+	}
+
+	_, err = s.svc.GetCity(context.Background(), &citiesv1.GetCityRequest{Id: "kyiv"})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("invalid id: code = %s, want %s", code, codes.InvalidArgument)
+	}
+}
+
+func TestBatchGetCities(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+	lviv := s.createCity(t, "Lviv", orb.Point{24.0297, 49.8397})
+	s.createCity(t, "Odesa", orb.Point{30.7233, 46.4825})
+	missing := uuid.New()
+
+	res, err := s.svc.BatchGetCities(context.Background(), &citiesv1.BatchGetCitiesRequest{
+		Ids: []string{lviv.ID.String(), missing.String(), kyiv.ID.String(), lviv.ID.String(), missing.String()},
+	})
+	if err != nil {
+		t.Fatalf("BatchGetCities: %v", err)
+	}
+
+	if len(res.GetCities()) != 2 || res.GetCities()[0].GetId() != lviv.ID.String() || res.GetCities()[1].GetId() != kyiv.ID.String() {
+		t.Errorf("cities = %v, want Lviv and Kyiv once each in request order", res.GetCities())
+	}
+	if len(res.GetNotFound()) != 1 || res.GetNotFound()[0] != missing.String() {
+		t.Errorf("not_found = %v, want [%s]", res.GetNotFound(), missing)
+	}
+
+	_, err = s.svc.BatchGetCities(context.Background(), &citiesv1.BatchGetCitiesRequest{Ids: []string{"kyiv"}})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("invalid id: code = %s, want %s", code, codes.InvalidArgument)
+	}
+
+	ids := make([]string, maxBatchSize+1)
+	for i := range ids {
+		ids[i] = uuid.NewString()
+	}
+	_, err = s.svc.BatchGetCities(context.Background(), &citiesv1.BatchGetCitiesRequest{Ids: ids})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("too many ids: code = %s, want %s", code, codes.InvalidArgument)
+	}
+}
+
+func TestLocateCity(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+
+	res, err := s.svc.LocateCity(context.Background(), &citiesv1.LocateCityRequest{
+		Point:   &citiesv1.Point{Latitude: 50.45, Longitude: 30.52},
+		RadiusM: 10_000,
+	})
+	if err != nil || res.GetId() != kyiv.ID.String() {
+		t.Errorf("LocateCity = %v, %v", res, err)
+	}
+
+	_, err = s.svc.LocateCity(context.Background(), &citiesv1.LocateCityRequest{
+		Point:   &citiesv1.Point{Latitude: 49.84, Longitude: 24.03},
+		RadiusM: 10_000,
+	})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("far from any city: code = %s, want %s", code, codes.NotFound)
+	}
+
+	_, err = s.svc.LocateCity(context.Background(), &citiesv1.LocateCityRequest{
+		Point:   &citiesv1.Point{Latitude: 91, Longitude: 30.52},
+		RadiusM: 10_000,
+	})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("invalid latitude: code = %s, want %s", code, codes.InvalidArgument)
+	}
+}
+
+func TestGetCityAdmin(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+	moderator := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+
+	res, err := s.svc.GetCityAdmin(context.Background(), &citiesv1.GetCityAdminRequest{
+		CityId: kyiv.ID.String(),
+		UserId: moderator.UserID.String(),
+	})
+	if err != nil || res.GetRole() != enum.CityAdminRoleModerator {
+		t.Errorf("GetCityAdmin = %v, %v", res, err)
+	}
+
+	_, err = s.svc.GetCityAdmin(context.Background(), &citiesv1.GetCityAdminRequest{
+		CityId: kyiv.ID.String(),
+		UserId: uuid.NewString(),
+	})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("not an admin: code = %s, want %s", code, codes.NotFound)
+	}
+}
+
+func TestCheckPermission(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+	moderator := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+
+	for _, tc := range []struct {
+		name    string
+		userID  uuid.UUID
+		roles   []string
+		allowed bool
+		role    string
+	}{
+		{name: "any role", userID: moderator.UserID, allowed: true, role: enum.CityAdminRoleModerator},
+		{name: "matching role", userID: moderator.UserID, roles: []string{enum.CityAdminRoleTechLead, enum.CityAdminRoleModerator}, allowed: true, role: enum.CityAdminRoleModerator},
+		{name: "other role", userID: moderator.UserID, roles: []string{enum.CityAdminRoleTechLead}, allowed: false, role: enum.CityAdminRoleModerator},
+		{name: "not an admin", userID: uuid.New(), allowed: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.svc.CheckPermission(context.Background(), &citiesv1.CheckPermissionRequest{
+				CityId: kyiv.ID.String(),
+				UserId: tc.userID.String(),
+				Roles:  tc.roles,
+			})
+			if err != nil {
+				t.Fatalf("CheckPermission: %v", err)
+			}
+			if res.GetAllowed() != tc.allowed || res.GetRole() != tc.role {
+				t.Errorf("CheckPermission = %+v, want allowed %t, role %q", res, tc.allowed, tc.role)
+			}
+		})
+	}
+
+	_, err := s.svc.CheckPermission(context.Background(), &citiesv1.CheckPermissionRequest{
+		CityId: kyiv.ID.String(),
+		UserId: moderator.UserID.String(),
+		Roles:  []string{"owner"},
+	})
+	if code := status.Code(err); code != codes.InvalidArgument {
+		t.Errorf("unknown role: code = %s, want %s", code, codes.InvalidArgument)
+	}
+
+	lviv := s.createCity(t, "Lviv", orb.Point{24.0297, 49.8397})
+	res, err := s.svc.CheckPermission(context.Background(), &citiesv1.CheckPermissionRequest{
+		CityId: lviv.ID.String(),
+		UserId: moderator.UserID.String(),
+	})
+	if err != nil || res.GetAllowed() || res.GetRole() != "" {
+		t.Errorf("admin of another city: CheckPermission = %+v, %v, want not allowed", res, err)
+	}
+
+	_, err = s.svc.CheckPermission(context.Background(), &citiesv1.CheckPermissionRequest{
+		CityId: uuid.NewString(),
+		UserId: moderator.UserID.String(),
+	})
+	if code := status.Code(err); code != codes.NotFound {
+		t.Errorf("unknown city: code = %s, want %s", code, codes.NotFound)
+	}
+}
+
+func TestInvites(t *testing.T) {
+	s := newSetup()
+	kyiv := s.createCity(t, "Kyiv", orb.Point{30.5234, 50.4501})
+	techLead := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+	invitee := uuid.New()
+
+	create := func(ctx context.Context, userID uuid.UUID) (*citiesv1.Invite, error) {
+		return s.svc.CreateInvite(ctx, &citiesv1.CreateInviteRequest{
+			CityId: kyiv.ID.String(),
+			UserId: userID.String(),
+			Role:   enum.CityAdminRoleModerator,
+		})
+	}
+
+	inv, err := create(as(techLead.UserID, roles.SystemUser), invitee)
+	if err != nil || inv.GetStatus() != enum.InviteStatusSent || inv.GetInitiatorId() != techLead.UserID.String() {
+		t.Fatalf("CreateInvite by tech lead = %v, %v", inv, err)
+	}
+	if _, err = create(as(uuid.New(), roles.SystemUser), uuid.New()); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateInvite by stranger: code = %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if _, err = create(as(uuid.New(), roles.SystemAdmin), uuid.New()); err != nil {
+		t.Errorf("CreateInvite by sysadmin: %v", err)
+	}
+	if _, err = create(as(uuid.New(), roles.SystemSuperUser), uuid.New()); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("CreateInvite by other role: code = %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+
+	got, err := s.svc.GetInvite(context.Background(), &citiesv1.GetInviteRequest{Id: inv.GetId()})
+	if err != nil || got.GetId() != inv.GetId() || got.GetUserId() != invitee.String() {
+		t.Errorf("GetInvite = %v, %v", got, err)
+	}
+	if _, err = s.svc.GetInvite(context.Background(), &citiesv1.GetInviteRequest{Id: uuid.NewString()}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetInvite missing: code = %s, want %s", status.Code(err), codes.NotFound)
+	}
+
+	pending, err := s.svc.ListPendingInvites(context.Background(), &citiesv1.ListPendingInvitesRequest{UserId: invitee.String()})
+	if err != nil || len(pending.GetInvites()) != 1 || pending.GetInvites()[0].GetId() != inv.GetId() {
+		t.Errorf("ListPendingInvites = %v, %v", pending, err)
+	}
+
+	reply := func(ctx context.Context, answer string) (*citiesv1.Invite, error) {
+		return s.svc.ReplyInvite(ctx, &citiesv1.ReplyInviteRequest{Id: inv.GetId(), Answer: answer})
+	}
+
+	if _, err = reply(as(invitee, roles.SystemUser), "maybe"); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ReplyInvite invalid answer: code = %s, want %s", status.Code(err), codes.InvalidArgument)
+	}
+	if _, err = reply(as(uuid.New(), roles.SystemUser), enum.InviteStatusAccepted); status.Code(err) != codes.PermissionDenied {
+		t.Errorf("ReplyInvite by another user: code = %s, want %s", status.Code(err), codes.PermissionDenied)
+	}
+	if res, err := reply(as(invitee, roles.SystemUser), enum.InviteStatusAccepted); err != nil || res.GetStatus() != enum.InviteStatusAccepted {
+		t.Fatalf("ReplyInvite = %v, %v", res, err)
+	}
+	if _, err = reply(as(invitee, roles.SystemUser), enum.InviteStatusDeclined); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ReplyInvite twice: code = %s, want %s", status.Code(err), codes.FailedPrecondition)
+	}
+
+	res, err := s.svc.CheckPermission(context.Background(), &citiesv1.CheckPermissionRequest{
+		CityId: kyiv.ID.String(),
+		UserId: invitee.String(),
+	})
+	if err != nil || !res.GetAllowed() || res.GetRole() != enum.CityAdminRoleModerator {
+		t.Errorf("accepted invitee: CheckPermission = %+v, %v", res, err)
+	}
+}
@@ -0,0 +1,33 @@
+package handlers
+
+import (
+	"context"
+
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) ListPendingInvites(
+	ctx context.Context,
+	req *citiesv1.ListPendingInvitesRequest,
+) (*citiesv1.ListPendingInvitesResponse, error) {
+	userID, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user_id: %v", err)
+	}
+
+	invites, err := s.domain.invite.GetPending(ctx, userID)
+	if err != nil {
+		s.log(ctx).WithError(err).Error("failed to list pending invites")
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	res := &citiesv1.ListPendingInvitesResponse{Invites: make([]*citiesv1.Invite, len(invites))}
+	for i, inv := range invites {
+		res.Invites[i] = inviteResponse(inv)
+	}
+
+	return res, nil
+}
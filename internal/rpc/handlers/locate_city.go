@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/paulmach/orb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) LocateCity(ctx context.Context, req *citiesv1.LocateCityRequest) (*citiesv1.City, error) {
+	p := req.GetPoint()
+	switch {
+	case p == nil:
+		return nil, status.Error(codes.InvalidArgument, "point is required")
+	case p.GetLatitude() < -90 || p.GetLatitude() > 90:
+		return nil, status.Error(codes.InvalidArgument, "invalid latitude")
+	case p.GetLongitude() < -180 || p.GetLongitude() > 180:
+		return nil, status.Error(codes.InvalidArgument, "invalid longitude")
+	case req.GetRadiusM() == 0:
+		return nil, status.Error(codes.InvalidArgument, "radius_m must be > 0")
+	}
+
+	res, err := s.domain.city.GetByRadius(ctx, orb.Point{p.GetLongitude(), p.GetLatitude()}, req.GetRadiusM())
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorCityNotFound):
+			return nil, status.Error(codes.NotFound, "no city found within radius")
+		default:
+			s.log(ctx).WithError(err).Error("failed to locate city")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return cityResponse(res), nil
+}
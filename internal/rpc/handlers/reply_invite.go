@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s Service) ReplyInvite(ctx context.Context, req *citiesv1.ReplyInviteRequest) (*citiesv1.Invite, error) {
+	user, err := meta.User(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "no user in context")
+	}
+
+	inviteID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid id: %v", err)
+	}
+
+	res, err := s.domain.invite.Reply(ctx, user.ID, inviteID, req.GetAnswer())
+	if err != nil {
+		switch {
+		case errors.Is(err, errx.ErrorInvalidInviteReply):
+			return nil, status.Error(codes.InvalidArgument, "answer must be accepted or declined")
+		case errors.Is(err, errx.ErrorInviteNotFound):
+			return nil, status.Error(codes.NotFound, "invite not found")
+		case errors.Is(err, errx.ErrorNotEnoughRight):
+			return nil, status.Error(codes.PermissionDenied, "invite is addressed to another user")
+		case errors.Is(err, errx.ErrorInviteAlreadyReplied):
+			return nil, status.Error(codes.FailedPrecondition, "invite already answered")
+		case errors.Is(err, errx.ErrorInviteExpired):
+			return nil, status.Error(codes.FailedPrecondition, "invite expired")
+		case errors.Is(err, errx.ErrorCityIsNotSupported):
+			return nil, status.Error(codes.FailedPrecondition, "city is not supported")
+		case errors.Is(err, errx.ErrorCityAdminAlreadyExists):
+			return nil, status.Error(codes.AlreadyExists, "user is already a city admin")
+		default:
+			s.log(ctx).WithError(err).Error("failed to reply to invite")
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+	}
+
+	return inviteResponse(res), nil
+}
@@ -0,0 +1,50 @@
+package handlers
+
+import (
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func cityResponse(m models.City) *citiesv1.City {
+	return &citiesv1.City{
+		Id:        m.ID.String(),
+		CountryId: m.CountryID,
+		Point: &citiesv1.Point{
+			Latitude:  m.Point.Lat(),
+			Longitude: m.Point.Lon(),
+		},
+		Status:    m.Status,
+		Name:      m.Name,
+		Icon:      m.Icon,
+		Slug:      m.Slug,
+		Timezone:  m.Timezone,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func cityAdminResponse(m models.CityAdmin) *citiesv1.CityAdmin {
+	return &citiesv1.CityAdmin{
+		UserId:    m.UserID.String(),
+		CityId:    m.CityID.String(),
+		Role:      m.Role,
+		Label:     m.Label,
+		Position:  m.Position,
+		CreatedAt: timestamppb.New(m.CreatedAt),
+		UpdatedAt: timestamppb.New(m.UpdatedAt),
+	}
+}
+
+func inviteResponse(m models.Invite) *citiesv1.Invite {
+	return &citiesv1.Invite{
+		Id:          m.ID.String(),
+		CityId:      m.CityID.String(),
+		UserId:      m.UserID.String(),
+		InitiatorId: m.InitiatorID.String(),
+		Status:      m.Status,
+		Role:        m.Role,
+		ExpiresAt:   timestamppb.New(m.ExpiresAt),
+		CreatedAt:   timestamppb.New(m.CreatedAt),
+	}
+}
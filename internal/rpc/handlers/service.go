@@ -0,0 +1,70 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+	"github.com/sirupsen/logrus"
+)
+
+type CitySvc interface {
+	Filter(
+		ctx context.Context,
+		filters city.FilterParams,
+		page, size uint64,
+	) (models.CitiesCollection, error)
+
+	GetByID(ctx context.Context, cityID uuid.UUID) (models.City, error)
+	GetByIDs(ctx context.Context, ids []uuid.UUID) ([]models.City, []uuid.UUID, error)
+	GetByRadius(ctx context.Context, point orb.Point, radius uint64) (models.City, error)
+}
+
+type CityAdminSvc interface {
+	Get(ctx context.Context, userID, cityID uuid.UUID) (models.CityAdmin, error)
+}
+
+type InviteSvc interface {
+	Get(ctx context.Context, ID uuid.UUID) (models.Invite, error)
+	GetPending(ctx context.Context, userID uuid.UUID) ([]models.Invite, error)
+	CreateByCityAdmin(ctx context.Context, initiatorID uuid.UUID, params invite.CreateParams) (models.Invite, error)
+	CreateBySysAdmin(ctx context.Context, initiatorID uuid.UUID, params invite.CreateParams) (models.Invite, error)
+	Reply(ctx context.Context, userID, inviteID uuid.UUID, reply string) (models.Invite, error)
+}
+
+type domain struct {
+	city   CitySvc
+	admin  CityAdminSvc
+	invite InviteSvc
+}
+
+type Service struct {
+	citiesv1.UnimplementedCitiesServiceServer
+
+	domain domain
+	logger logium.Logger
+}
+
+func New(log logium.Logger, city CitySvc, admin CityAdminSvc, invite InviteSvc) Service {
+	return Service{
+		logger: log,
+		domain: domain{
+			city:   city,
+			admin:  admin,
+			invite: invite,
+		},
+	}
+}
+
+// log returns the logger of the request handled under ctx, tagged with its
+// request id.
+func (s Service) log(ctx context.Context) logrus.FieldLogger {
+	return requestid.Log(ctx, s.logger)
+}
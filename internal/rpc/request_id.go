@@ -0,0 +1,36 @@
+package rpc
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// requestIDKey is the metadata key of the request id, the gRPC spelling of
+// requestid.Header.
+var requestIDKey = strings.ToLower(requestid.Header)
+
+// RequestID takes the request id from the x-request-id metadata, or generates
+// one when it is missing or invalid, puts it into the context and returns it
+// in the response header, the same as the REST middleware does.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		id := ""
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if values := md.Get(requestIDKey); len(values) > 0 {
+				id = values[0]
+			}
+		}
+		if !requestid.Valid(id) {
+			id = requestid.New()
+		}
+
+		// fails only outside of a server stream, e.g. in tests
+		_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDKey, id))
+
+		return handler(requestid.With(ctx, id), req)
+	}
+}
@@ -0,0 +1,48 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestRequestID(t *testing.T) {
+	interceptor := RequestID()
+
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return requestid.From(ctx), nil
+	}
+
+	for _, tc := range []struct {
+		name string
+		md   metadata.MD
+		kept bool
+	}{
+		{name: "sent by client", md: metadata.Pairs("x-request-id", "req-1"), kept: true},
+		{name: "invalid", md: metadata.Pairs("x-request-id", "with space")},
+		{name: "no metadata"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.md != nil {
+				ctx = metadata.NewIncomingContext(ctx, tc.md)
+			}
+
+			res, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler)
+			if err != nil {
+				t.Fatalf("interceptor: %v", err)
+			}
+
+			id := res.(string)
+			switch {
+			case tc.kept && id != "req-1":
+				t.Errorf("request id = %q, want req-1", id)
+			case !tc.kept && (!requestid.Valid(id) || id == "with space"):
+				t.Errorf("request id = %q, want a generated one", id)
+			}
+		})
+	}
+}
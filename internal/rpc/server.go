@@ -0,0 +1,59 @@
+package rpc
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/rest/meta"
+	citiesv1 "github.com/chains-lab/cities-svc/proto/cities/v1"
+	"github.com/chains-lab/logium"
+	"github.com/chains-lab/restkit/mdlv"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+)
+
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, h citiesv1.CitiesServiceServer) {
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(RequestID(), Auth(mdlv.Auth(meta.UserCtxKey, cfg.JWT.User.AccessToken.SecretKey))),
+	)
+	citiesv1.RegisterCitiesServiceServer(srv, h)
+
+	lis, err := net.Listen("tcp", cfg.GRPC.Port)
+	if err != nil {
+		log.Errorf("gRPC listen error: %v", err)
+		return
+	}
+
+	log.Infof("starting gRPC service on %s", cfg.GRPC.Port)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- srv.Serve(lis)
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Info("shutting down gRPC service...")
+	case err = <-errCh:
+		if err != nil {
+			log.Errorf("gRPC server error: %v", err)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+		log.Info("gRPC server stopped")
+	case <-time.After(5 * time.Second):
+		srv.Stop()
+		log.Error("gRPC graceful shutdown timed out, connections closed")
+	}
+}
@@ -0,0 +1,175 @@
+package stream
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/google/uuid"
+)
+
+// Events lists the event types streamed to city admin dashboards, the rest
+// are ignored by Broadcast.
+var Events = []string{
+	contracts.CityUpdatedEvent,
+	contracts.CityUpdatedStatusSupportedEvent,
+	contracts.CityUpdatedStatusSuspendedEvent,
+	contracts.CityUpdatedStatusUnsupportedEvent,
+	contracts.CityAdminCreatedEvent,
+	contracts.CityAdminDeletedEvent,
+	contracts.InviteAcceptedEvent,
+	contracts.InviteDeclinedEvent,
+}
+
+// subscriberBuffer is how many events a subscriber may lag behind before it
+// is dropped, dropped subscribers resume from the city buffer on reconnect.
+const subscriberBuffer = 64
+
+// Event is a change of a city as sent to subscribers. IDs are "<epoch>-<seq>",
+// the epoch changes on every start of the process so IDs handed out by a
+// previous process are never mistaken for current ones.
+type Event struct {
+	ID   string
+	Type string
+	Data []byte
+}
+
+// Subscription receives the events of a city until it is closed by
+// Unsubscribe or because the subscriber fell behind.
+type Subscription struct {
+	C <-chan Event
+
+	ch     chan Event
+	cityID uuid.UUID
+}
+
+type city struct {
+	events []Event
+	seqs   []uint64
+	// evicted is the seq of the last event dropped from the buffer.
+	evicted uint64
+	subs    map[*Subscription]struct{}
+}
+
+// Broadcaster fans the changes of a city out to its subscribers in-process
+// and keeps the last events of every city so clients can resume after a
+// reconnect.
+type Broadcaster struct {
+	mu     sync.Mutex
+	epoch  string
+	seq    uint64
+	buffer int
+	cities map[uuid.UUID]*city
+}
+
+// NewBroadcaster keeps up to buffer events per city for resuming.
+func NewBroadcaster(buffer int) *Broadcaster {
+	return &Broadcaster{
+		epoch:  strconv.FormatInt(time.Now().UnixNano(), 36),
+		buffer: buffer,
+		cities: make(map[uuid.UUID]*city),
+	}
+}
+
+// Broadcast sends the event to the subscribers of the city.
+func (b *Broadcaster) Broadcast(cityID uuid.UUID, eventType string, data []byte) {
+	if !slices.Contains(Events, eventType) {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	event := Event{
+		ID:   b.epoch + "-" + strconv.FormatUint(b.seq, 10),
+		Type: eventType,
+		Data: data,
+	}
+
+	c := b.city(cityID)
+	c.events = append(c.events, event)
+	c.seqs = append(c.seqs, b.seq)
+	if n := len(c.events) - b.buffer; n > 0 {
+		c.evicted = c.seqs[n-1]
+		c.events = slices.Delete(c.events, 0, n)
+		c.seqs = slices.Delete(c.seqs, 0, n)
+	}
+
+	for sub := range c.subs {
+		select {
+		case sub.ch <- event:
+		default:
+			b.drop(c, sub)
+		}
+	}
+}
+
+// Subscribe registers a subscriber of the city. With a lastEventID it also
+// returns the buffered events that followed it, ok is false when the event is
+// no longer buffered and the client has to reload the city instead.
+func (b *Broadcaster) Subscribe(cityID uuid.UUID, lastEventID string) (sub *Subscription, missed []Event, ok bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c := b.city(cityID)
+
+	ok = true
+	if lastEventID != "" {
+		missed, ok = b.since(c, lastEventID)
+	}
+
+	ch := make(chan Event, subscriberBuffer)
+	sub = &Subscription{C: ch, ch: ch, cityID: cityID}
+	c.subs[sub] = struct{}{}
+
+	return sub, missed, ok
+}
+
+// Unsubscribe removes the subscriber and closes its channel.
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if c, ok := b.cities[sub.cityID]; ok {
+		b.drop(c, sub)
+	}
+}
+
+func (b *Broadcaster) city(cityID uuid.UUID) *city {
+	c, ok := b.cities[cityID]
+	if !ok {
+		c = &city{subs: make(map[*Subscription]struct{})}
+		b.cities[cityID] = c
+	}
+
+	return c
+}
+
+func (b *Broadcaster) drop(c *city, sub *Subscription) {
+	if _, ok := c.subs[sub]; !ok {
+		return
+	}
+	delete(c.subs, sub)
+	close(sub.ch)
+}
+
+// since returns the buffered events after the given one. Events evicted
+// from the buffer, or issued by another process, can't be resumed from.
+func (b *Broadcaster) since(c *city, lastEventID string) ([]Event, bool) {
+	epoch, raw, found := strings.Cut(lastEventID, "-")
+	if !found || epoch != b.epoch {
+		return nil, false
+	}
+	seq, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil || seq > b.seq || seq < c.evicted {
+		return nil, false
+	}
+
+	i, _ := slices.BinarySearch(c.seqs, seq+1)
+
+	return slices.Clone(c.events[i:]), true
+}
@@ -0,0 +1,139 @@
+package stream
+
+import (
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/events/contracts"
+	"github.com/google/uuid"
+)
+
+func receive(t *testing.T, sub *Subscription) Event {
+	t.Helper()
+
+	select {
+	case event, ok := <-sub.C:
+		if !ok {
+			t.Fatal("subscription closed")
+		}
+		return event
+	default:
+		t.Fatal("no event")
+	}
+
+	return Event{}
+}
+
+func TestBroadcast(t *testing.T) {
+	b := NewBroadcaster(10)
+	kyiv, lviv := uuid.New(), uuid.New()
+
+	sub, missed, ok := b.Subscribe(kyiv, "")
+	if !ok || len(missed) != 0 {
+		t.Fatalf("Subscribe = %d missed, ok %t", len(missed), ok)
+	}
+	defer b.Unsubscribe(sub)
+
+	b.Broadcast(kyiv, contracts.CityUpdatedEvent, []byte(`{"n":1}`))
+	b.Broadcast(lviv, contracts.CityUpdatedEvent, []byte(`{"n":2}`))
+	b.Broadcast(kyiv, contracts.CityCreatedEvent, []byte(`{"n":3}`))
+	b.Broadcast(kyiv, contracts.InviteAcceptedEvent, []byte(`{"n":4}`))
+
+	if got := receive(t, sub); got.Type != contracts.CityUpdatedEvent || string(got.Data) != `{"n":1}` {
+		t.Errorf("first event = %+v", got)
+	}
+	if got := receive(t, sub); got.Type != contracts.InviteAcceptedEvent || string(got.Data) != `{"n":4}` {
+		t.Errorf("second event = %+v", got)
+	}
+	if len(sub.C) != 0 {
+		t.Errorf("%d unexpected events", len(sub.C))
+	}
+}
+
+func TestSubscribeResume(t *testing.T) {
+	b := NewBroadcaster(3)
+	cityID := uuid.New()
+
+	sub, _, _ := b.Subscribe(cityID, "")
+	for range 3 {
+		b.Broadcast(cityID, contracts.CityUpdatedEvent, []byte(`{}`))
+	}
+	first, second, third := receive(t, sub), receive(t, sub), receive(t, sub)
+	b.Unsubscribe(sub)
+
+	if _, open := <-sub.C; open {
+		t.Error("subscription is not closed after Unsubscribe")
+	}
+
+	t.Run("missed events", func(t *testing.T) {
+		sub, missed, ok := b.Subscribe(cityID, first.ID)
+		defer b.Unsubscribe(sub)
+
+		if !ok || len(missed) != 2 || missed[0].ID != second.ID || missed[1].ID != third.ID {
+			t.Errorf("Subscribe = %+v, ok %t", missed, ok)
+		}
+	})
+
+	t.Run("up to date", func(t *testing.T) {
+		sub, missed, ok := b.Subscribe(cityID, third.ID)
+		defer b.Unsubscribe(sub)
+
+		if !ok || len(missed) != 0 {
+			t.Errorf("Subscribe = %+v, ok %t", missed, ok)
+		}
+	})
+
+	t.Run("evicted", func(t *testing.T) {
+		b.Broadcast(cityID, contracts.CityUpdatedEvent, []byte(`{}`))
+
+		// Only the first event is evicted, nothing after it was lost.
+		sub, missed, ok := b.Subscribe(cityID, first.ID)
+		b.Unsubscribe(sub)
+		if !ok || len(missed) != 3 || missed[0].ID != second.ID {
+			t.Errorf("Subscribe = %+v, ok %t", missed, ok)
+		}
+
+		b.Broadcast(cityID, contracts.CityUpdatedEvent, []byte(`{}`))
+
+		sub, missed, ok = b.Subscribe(cityID, first.ID)
+		b.Unsubscribe(sub)
+		if ok {
+			t.Errorf("Subscribe = %+v, ok %t", missed, ok)
+		}
+	})
+
+	for name, id := range map[string]string{
+		"other epoch": "0-1",
+		"future":      b.epoch + "-100",
+		"malformed":   "nope",
+	} {
+		t.Run(name, func(t *testing.T) {
+			sub, _, ok := b.Subscribe(cityID, id)
+			defer b.Unsubscribe(sub)
+
+			if ok {
+				t.Errorf("Subscribe(%q) ok", id)
+			}
+		})
+	}
+}
+
+func TestSlowSubscriberDropped(t *testing.T) {
+	b := NewBroadcaster(10)
+	cityID := uuid.New()
+
+	sub, _, _ := b.Subscribe(cityID, "")
+	for range subscriberBuffer + 1 {
+		b.Broadcast(cityID, contracts.CityUpdatedEvent, []byte(`{}`))
+	}
+
+	n := 0
+	for range sub.C {
+		n++
+	}
+	if n != subscriberBuffer {
+		t.Errorf("received %d events before close, want %d", n, subscriberBuffer)
+	}
+
+	// Already dropped, must not panic on a closed channel.
+	b.Unsubscribe(sub)
+}
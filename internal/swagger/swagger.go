@@ -0,0 +1,116 @@
+package swagger
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"html/template"
+	"io/fs"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chains-lab/cities-svc/docs"
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/logium"
+)
+
+const specFile = "openapi.yaml"
+
+// ui holds the Swagger UI assets, served from the binary so the page works
+// without access to a CDN. They are the swagger-ui-dist release pinned in
+// ui/VERSION, refresh them with `make swagger-ui`.
+//
+//go:embed ui
+var ui embed.FS
+
+var page = template.Must(template.New("swagger").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8"/>
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="{{.Assets}}/swagger-ui.css"/>
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="{{.Assets}}/swagger-ui-bundle.js"></script>
+<script>
+  window.onload = () => {
+    window.ui = SwaggerUIBundle({url: "{{.SpecURL}}", dom_id: "#swagger-ui"});
+  };
+</script>
+</body>
+</html>
+`))
+
+// Handler serves the embedded OpenAPI spec at <base>/openapi.yaml, the
+// Swagger UI page at <base>/ and its assets under <base>/assets/.
+func Handler(base, title string) http.Handler {
+	base = "/" + strings.Trim(base, "/")
+	if base == "/" {
+		base = ""
+	}
+	specURL := base + "/" + specFile
+	assets := base + "/assets"
+
+	files, err := fs.Sub(ui, "ui")
+	if err != nil {
+		panic(err) // the directory is embedded, it is always there
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(assets+"/", http.StripPrefix(assets, http.FileServerFS(files)))
+	mux.HandleFunc(specURL, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/yaml")
+		_, _ = w.Write(docs.Spec)
+	})
+	mux.HandleFunc(base+"/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != base+"/" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_ = page.Execute(w, struct{ Title, SpecURL, Assets string }{title, specURL, assets})
+	})
+	if base != "" {
+		mux.Handle(base, http.RedirectHandler(base+"/", http.StatusMovedPermanently))
+	}
+
+	return mux
+}
+
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger) {
+	srv := &http.Server{
+		Addr:              cfg.Swagger.Port,
+		Handler:           Handler(cfg.Swagger.URL, cfg.Server.Name),
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+
+	log.Infof("starting swagger service on %s%s", cfg.Swagger.Port, cfg.Swagger.URL)
+
+	errCh := make(chan error, 1)
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		} else {
+			errCh <- nil
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		log.Info("shutting down swagger service...")
+	case err := <-errCh:
+		if err != nil {
+			log.Errorf("swagger server error: %v", err)
+		}
+	}
+
+	shCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shCtx); err != nil {
+		log.Errorf("swagger shutdown error: %v", err)
+	} else {
+		log.Info("swagger server stopped")
+	}
+}
@@ -0,0 +1,39 @@
+package swagger
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	h := Handler("/docs", "cities-svc")
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	page := get("/docs/")
+	if page.Code != http.StatusOK {
+		t.Fatalf("page status = %d", page.Code)
+	}
+	body := page.Body.String()
+	if strings.Contains(body, "https://") {
+		t.Errorf("page loads assets from outside the binary:\n%s", body)
+	}
+	for _, ref := range []string{`href="/docs/assets/swagger-ui.css"`, `src="/docs/assets/swagger-ui-bundle.js"`} {
+		if !strings.Contains(body, ref) {
+			t.Errorf("page does not reference %s", ref)
+		}
+	}
+
+	if rec := get("/docs/assets/VERSION"); rec.Code != http.StatusOK || strings.TrimSpace(rec.Body.String()) == "" {
+		t.Errorf("embedded assets not served, status %d", rec.Code)
+	}
+	if rec := get("/docs/openapi.yaml"); rec.Code != http.StatusOK {
+		t.Errorf("spec status = %d", rec.Code)
+	}
+}
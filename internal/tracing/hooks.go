@@ -0,0 +1,121 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Hooks creates spans for SQL statements and Kafka publishes and injects
+// the trace context into outgoing Kafka headers.
+type Hooks struct {
+	tracer trace.Tracer
+}
+
+func NewHooks() *Hooks {
+	return &Hooks{
+		tracer: otel.Tracer(instrumentationName),
+	}
+}
+
+type spanKey struct{}
+
+func (h *Hooks) start(ctx context.Context, name string, opts ...trace.SpanStartOption) context.Context {
+	ctx, span := h.tracer.Start(ctx, name, opts...)
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+func (h *Hooks) end(ctx context.Context, err error) {
+	span, ok := ctx.Value(spanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// BeforeQuery implements pgdb.QueryHook.
+func (h *Hooks) BeforeQuery(ctx context.Context, table, op string) context.Context {
+	name := "pgdb." + op
+	attrs := []trace.SpanStartOption{
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBOperationName(op)),
+	}
+	if table != "" {
+		name += " " + table
+		attrs = append(attrs, trace.WithAttributes(semconv.DBCollectionName(table)))
+	}
+
+	return h.start(ctx, name, attrs...)
+}
+
+// AfterQuery implements pgdb.QueryHook.
+func (h *Hooks) AfterQuery(ctx context.Context, _, _ string, err error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		err = nil
+	}
+	h.end(ctx, err)
+}
+
+// BeforePublish implements publisher.PublishHook.
+func (h *Hooks) BeforePublish(ctx context.Context, topic string, msg *kafka.Message) context.Context {
+	ctx = h.start(ctx, "kafka.publish "+topic,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingOperationTypePublish,
+			semconv.MessagingDestinationName(topic),
+		),
+	)
+
+	otel.GetTextMapPropagator().Inject(ctx, headerCarrier{msg: msg})
+
+	return ctx
+}
+
+// AfterPublish implements publisher.PublishHook.
+func (h *Hooks) AfterPublish(ctx context.Context, _ string, err error) {
+	h.end(ctx, err)
+}
+
+// headerCarrier adapts kafka message headers to propagation.TextMapCarrier.
+type headerCarrier struct {
+	msg *kafka.Message
+}
+
+func (c headerCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func (c headerCarrier) Set(key, value string) {
+	for i, h := range c.msg.Headers {
+		if h.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+	c.msg.Headers = append(c.msg.Headers, kafka.Header{Key: key, Value: []byte(value)})
+}
+
+func (c headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		keys = append(keys, h.Key)
+	}
+	return keys
+}
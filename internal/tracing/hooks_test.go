@@ -0,0 +1,75 @@
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupRecorder(t *testing.T) *tracetest.SpanRecorder {
+	t.Helper()
+
+	sr := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(sr))
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+
+	return sr
+}
+
+func TestHeaderCarrier(t *testing.T) {
+	sr := setupRecorder(t)
+	h := NewHooks()
+
+	msg := kafka.Message{Headers: []kafka.Header{
+		{Key: "traceparent", Value: []byte("stale")},
+		{Key: "event_type", Value: []byte("city.created")},
+	}}
+
+	ctx := h.BeforePublish(context.Background(), "cities.v1", &msg)
+	h.AfterPublish(ctx, "cities.v1", nil)
+
+	if len(msg.Headers) != 2 {
+		t.Fatalf("headers = %v, want traceparent replaced in place", msg.Headers)
+	}
+
+	extracted := otel.GetTextMapPropagator().Extract(context.Background(), headerCarrier{msg: &msg})
+	got := trace.SpanContextFromContext(extracted)
+	want := trace.SpanContextFromContext(ctx)
+	if !got.IsValid() || got.TraceID() != want.TraceID() || got.SpanID() != want.SpanID() {
+		t.Errorf("extracted span context = %v, want %v", got, want)
+	}
+
+	spans := sr.Ended()
+	if len(spans) != 1 || spans[0].Name() != "kafka.publish cities.v1" || spans[0].SpanKind() != trace.SpanKindProducer {
+		t.Fatalf("spans = %v", spans)
+	}
+}
+
+func TestQuerySpans(t *testing.T) {
+	sr := setupRecorder(t)
+	h := NewHooks()
+
+	h.AfterQuery(h.BeforeQuery(context.Background(), "cities", "select"), "cities", "select", sql.ErrNoRows)
+	h.AfterQuery(h.BeforeQuery(context.Background(), "cities", "update"), "cities", "update", sql.ErrConnDone)
+
+	spans := sr.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("got %d spans, want 2", len(spans))
+	}
+	if spans[0].Name() != "pgdb.select cities" || spans[0].Status().Code != codes.Unset {
+		t.Errorf("select span = %s, %v", spans[0].Name(), spans[0].Status())
+	}
+	if spans[1].Name() != "pgdb.update cities" || spans[1].Status().Code != codes.Error {
+		t.Errorf("update span = %s, %v", spans[1].Name(), spans[1].Status())
+	}
+}
@@ -0,0 +1,177 @@
+// Package webhooks sends the pending deliveries recorded by the webhook domain
+// service to the subscribed endpoints.
+//
+// Requests are signed following the Standard Webhooks scheme: the
+// webhook-signature header is "v1," and the base64 HMAC-SHA256, keyed with the
+// webhook secret, of "<webhook-id>.<webhook-timestamp>.<body>". The id is the
+// event id, so it stays the same across retries and lets receivers drop
+// duplicates.
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+)
+
+const (
+	HeaderID        = "webhook-id"
+	HeaderTimestamp = "webhook-timestamp"
+	HeaderSignature = "webhook-signature"
+	HeaderEventType = "webhook-event-type"
+)
+
+// Sign returns the webhook-signature header value of a request.
+func Sign(secret, id string, ts time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%s.%d.", id, ts.Unix())
+	mac.Write(body)
+
+	return "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type deliveries interface {
+	Get(ctx context.Context, ID uuid.UUID) (models.Webhook, error)
+	Claim(ctx context.Context, limit uint64, lease time.Duration) ([]models.WebhookDelivery, error)
+	RecordAttempt(ctx context.Context, delivery models.WebhookDelivery, responseCode int, sendErr error) (models.WebhookDelivery, error)
+}
+
+type Dispatcher struct {
+	svc     deliveries
+	log     logium.Logger
+	client  *http.Client
+	timeout time.Duration
+	batch   uint64
+}
+
+func NewDispatcher(svc deliveries, log logium.Logger, timeout time.Duration, batch uint64) Dispatcher {
+	return Dispatcher{
+		svc:     svc,
+		log:     log,
+		client:  &http.Client{Timeout: timeout},
+		timeout: timeout,
+		batch:   batch,
+	}
+}
+
+// Run dispatches due deliveries every poll interval until ctx is done.
+func Run(ctx context.Context, cfg internal.Config, log logium.Logger, d Dispatcher) {
+	log.Infof("starting webhook dispatcher, polling every %s", cfg.Webhooks.PollInterval)
+
+	ticker := time.NewTicker(cfg.Webhooks.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("webhook dispatcher stopped")
+			return
+		case <-ticker.C:
+		}
+
+		// keep going while full batches come back, there is a backlog
+		for {
+			n, err := d.DispatchDue(ctx)
+			if err != nil {
+				log.WithError(err).Error("failed to dispatch webhook deliveries")
+			}
+			if err != nil || uint64(n) < d.batch {
+				break
+			}
+		}
+	}
+}
+
+// DispatchDue sends one batch of due deliveries concurrently and records the
+// outcome of each, it returns the number of deliveries in the batch. Each
+// delivery is sent and logged with the id of the request which caused it.
+func (d Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	// a delivery is leased for longer than a send can take
+	batch, err := d.svc.Claim(ctx, d.batch, 2*d.timeout)
+	if err != nil {
+		return 0, err
+	}
+
+	webhooks := make(map[uuid.UUID]models.Webhook)
+	for _, del := range batch {
+		if _, ok := webhooks[del.WebhookID]; ok {
+			continue
+		}
+		w, err := d.svc.Get(ctx, del.WebhookID)
+		if err != nil {
+			return 0, err
+		}
+		webhooks[del.WebhookID] = w
+	}
+
+	var wg sync.WaitGroup
+	for _, del := range batch {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			ctx := ctx
+			if del.RequestID != "" {
+				ctx = requestid.With(ctx, del.RequestID)
+			}
+
+			code, sendErr := d.send(ctx, webhooks[del.WebhookID], del)
+			res, err := d.svc.RecordAttempt(ctx, del, code, sendErr)
+			switch {
+			case err != nil:
+				requestid.Log(ctx, d.log).WithError(err).Errorf("failed to record webhook delivery %s", del.ID)
+			case sendErr != nil:
+				requestid.Log(ctx, d.log).WithError(sendErr).Infof("webhook delivery %s attempt %d failed, status %s", del.ID, res.Attempts, res.Status)
+			}
+		}()
+	}
+	wg.Wait()
+
+	return len(batch), nil
+}
+
+// send posts the payload, any response but 2xx is a failure.
+func (d Dispatcher) send(ctx context.Context, w models.Webhook, del models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(del.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("build request: %w", err)
+	}
+
+	now := time.Now()
+	id := del.EventID.String()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, id)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(w.Secret, id, now, del.Payload))
+	req.Header.Set(HeaderEventType, del.EventType)
+	if del.RequestID != "" {
+		req.Header.Set(requestid.Header, del.RequestID)
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("endpoint responded %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
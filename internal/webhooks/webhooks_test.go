@@ -0,0 +1,129 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/chains-lab/cities-svc/internal/requestid"
+	"github.com/chains-lab/logium"
+	"github.com/google/uuid"
+)
+
+func TestSign(t *testing.T) {
+	ts := time.Unix(1700000000, 0)
+	body := []byte(`{"events":"city.created"}`)
+
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write([]byte("msg_1.1700000000." + string(body)))
+	want := "v1," + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("s3cret", "msg_1", ts, body); got != want {
+		t.Errorf("Sign = %s, want %s", got, want)
+	}
+	if Sign("other", "msg_1", ts, body) == want {
+		t.Error("signature does not depend on the secret")
+	}
+}
+
+type request struct {
+	header http.Header
+	body   []byte
+}
+
+func TestDispatchDue(t *testing.T) {
+	ctx := context.Background()
+
+	var (
+		mu       sync.Mutex
+		requests []request
+		status   = http.StatusNoContent
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mu.Lock()
+		defer mu.Unlock()
+		requests = append(requests, request{header: r.Header, body: body})
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	svc := webhook.NewService(memory.New(), webhook.Retry{MaxAttempts: 2, Backoff: time.Hour, MaxBackoff: time.Hour})
+	d := NewDispatcher(svc, logium.NewLogger("error", "text"), 5*time.Second, 10)
+
+	w, err := svc.Create(ctx, webhook.CreateParams{URL: srv.URL, Secret: "s3cret"})
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+
+	eventID := uuid.New()
+	payload := []byte(`{"events":"city.created"}`)
+	if err = svc.Enqueue(requestid.With(ctx, "req-1"), eventID, "city.created", payload); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	n, err := d.DispatchDue(ctx)
+	if err != nil || n != 1 {
+		t.Fatalf("dispatch: %v, sent %d, want 1", err, n)
+	}
+
+	if len(requests) != 1 {
+		t.Fatalf("endpoint got %d requests, want 1", len(requests))
+	}
+	req := requests[0]
+	if string(req.body) != string(payload) {
+		t.Errorf("body = %s, want %s", req.body, payload)
+	}
+	if req.header.Get(HeaderID) != eventID.String() || req.header.Get(HeaderEventType) != "city.created" ||
+		req.header.Get(requestid.Header) != "req-1" {
+		t.Errorf("unexpected headers %v", req.header)
+	}
+	sec, err := strconv.ParseInt(req.header.Get(HeaderTimestamp), 10, 64)
+	if err != nil {
+		t.Fatalf("timestamp header: %v", err)
+	}
+	if want := Sign(w.Secret, eventID.String(), time.Unix(sec, 0), payload); req.header.Get(HeaderSignature) != want {
+		t.Errorf("signature = %s, want %s", req.header.Get(HeaderSignature), want)
+	}
+
+	res, err := svc.FilterDeliveries(ctx, webhook.FilterDeliveriesParams{}, 1, 10)
+	if err != nil || len(res.Data) != 1 || res.Data[0].Status != enum.WebhookDeliveryStatusDelivered {
+		t.Fatalf("deliveries %v %+v, want one delivered", err, res.Data)
+	}
+
+	// a failing endpoint leaves the delivery pending for a retry
+	status = http.StatusInternalServerError
+	if err = svc.Enqueue(ctx, uuid.New(), "city.created", payload); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if n, err = d.DispatchDue(ctx); err != nil || n != 1 {
+		t.Fatalf("dispatch: %v, sent %d, want 1", err, n)
+	}
+
+	res, err = svc.FilterDeliveries(ctx, webhook.FilterDeliveriesParams{
+		Status: []string{enum.WebhookDeliveryStatusPending},
+	}, 1, 10)
+	if err != nil || len(res.Data) != 1 {
+		t.Fatalf("pending deliveries %v %+v, want one", err, res.Data)
+	}
+	failed := res.Data[0]
+	if failed.Attempts != 1 || failed.ResponseCode == nil || *failed.ResponseCode != 500 || failed.LastError == nil {
+		t.Errorf("failed attempt is not recorded: %+v", failed)
+	}
+
+	if n, err = d.DispatchDue(ctx); err != nil || n != 0 {
+		t.Errorf("delivery is retried before its backoff: %v, sent %d", err, n)
+	}
+}
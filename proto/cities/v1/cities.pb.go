@@ -0,0 +1,1173 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.8
+// 	protoc        v5.29.3
+// source: cities/v1/cities.proto
+
+package citiesv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Point struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Point) Reset() {
+	*x = Point{}
+	mi := &file_cities_v1_cities_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Point) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Point) ProtoMessage() {}
+
+func (x *Point) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Point.ProtoReflect.Descriptor instead.
+func (*Point) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Point) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Point) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type City struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CountryId     string                 `protobuf:"bytes,2,opt,name=country_id,json=countryId,proto3" json:"country_id,omitempty"`
+	Point         *Point                 `protobuf:"bytes,3,opt,name=point,proto3" json:"point,omitempty"`
+	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Icon          *string                `protobuf:"bytes,6,opt,name=icon,proto3,oneof" json:"icon,omitempty"`
+	Slug          *string                `protobuf:"bytes,7,opt,name=slug,proto3,oneof" json:"slug,omitempty"`
+	Timezone      string                 `protobuf:"bytes,8,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	mi := &file_cities_v1_cities_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *City) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *City) GetCountryId() string {
+	if x != nil {
+		return x.CountryId
+	}
+	return ""
+}
+
+func (x *City) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *City) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *City) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *City) GetIcon() string {
+	if x != nil && x.Icon != nil {
+		return *x.Icon
+	}
+	return ""
+}
+
+func (x *City) GetSlug() string {
+	if x != nil && x.Slug != nil {
+		return *x.Slug
+	}
+	return ""
+}
+
+func (x *City) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *City) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *City) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type CityAdmin struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CityId        string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Label         *string                `protobuf:"bytes,4,opt,name=label,proto3,oneof" json:"label,omitempty"`
+	Position      *string                `protobuf:"bytes,5,opt,name=position,proto3,oneof" json:"position,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CityAdmin) Reset() {
+	*x = CityAdmin{}
+	mi := &file_cities_v1_cities_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CityAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CityAdmin) ProtoMessage() {}
+
+func (x *CityAdmin) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CityAdmin.ProtoReflect.Descriptor instead.
+func (*CityAdmin) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CityAdmin) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CityAdmin) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CityAdmin) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *CityAdmin) GetLabel() string {
+	if x != nil && x.Label != nil {
+		return *x.Label
+	}
+	return ""
+}
+
+func (x *CityAdmin) GetPosition() string {
+	if x != nil && x.Position != nil {
+		return *x.Position
+	}
+	return ""
+}
+
+func (x *CityAdmin) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CityAdmin) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type GetCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCityRequest) Reset() {
+	*x = GetCityRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCityRequest) ProtoMessage() {}
+
+func (x *GetCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCityRequest.ProtoReflect.Descriptor instead.
+func (*GetCityRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetCityRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type BatchGetCitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Ids           []string               `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetCitiesRequest) Reset() {
+	*x = BatchGetCitiesRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetCitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCitiesRequest) ProtoMessage() {}
+
+func (x *BatchGetCitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCitiesRequest.ProtoReflect.Descriptor instead.
+func (*BatchGetCitiesRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchGetCitiesRequest) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type BatchGetCitiesResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Cities []*City                `protobuf:"bytes,1,rep,name=cities,proto3" json:"cities,omitempty"`
+	// ids from the request that do not match any city
+	NotFound      []string `protobuf:"bytes,2,rep,name=not_found,json=notFound,proto3" json:"not_found,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchGetCitiesResponse) Reset() {
+	*x = BatchGetCitiesResponse{}
+	mi := &file_cities_v1_cities_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchGetCitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchGetCitiesResponse) ProtoMessage() {}
+
+func (x *BatchGetCitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchGetCitiesResponse.ProtoReflect.Descriptor instead.
+func (*BatchGetCitiesResponse) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *BatchGetCitiesResponse) GetCities() []*City {
+	if x != nil {
+		return x.Cities
+	}
+	return nil
+}
+
+func (x *BatchGetCitiesResponse) GetNotFound() []string {
+	if x != nil {
+		return x.NotFound
+	}
+	return nil
+}
+
+type LocateCityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Point         *Point                 `protobuf:"bytes,1,opt,name=point,proto3" json:"point,omitempty"`
+	RadiusM       uint64                 `protobuf:"varint,2,opt,name=radius_m,json=radiusM,proto3" json:"radius_m,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LocateCityRequest) Reset() {
+	*x = LocateCityRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LocateCityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocateCityRequest) ProtoMessage() {}
+
+func (x *LocateCityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocateCityRequest.ProtoReflect.Descriptor instead.
+func (*LocateCityRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *LocateCityRequest) GetPoint() *Point {
+	if x != nil {
+		return x.Point
+	}
+	return nil
+}
+
+func (x *LocateCityRequest) GetRadiusM() uint64 {
+	if x != nil {
+		return x.RadiusM
+	}
+	return 0
+}
+
+type GetCityAdminRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCityAdminRequest) Reset() {
+	*x = GetCityAdminRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCityAdminRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCityAdminRequest) ProtoMessage() {}
+
+func (x *GetCityAdminRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCityAdminRequest.ProtoReflect.Descriptor instead.
+func (*GetCityAdminRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetCityAdminRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *GetCityAdminRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type CheckPermissionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *CheckPermissionRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type CheckPermissionResponse struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// role of the user in the city, empty if the user is not an admin
+	Role          string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionResponse) Reset() {
+	*x = CheckPermissionResponse{}
+	mi := &file_cities_v1_cities_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionResponse) ProtoMessage() {}
+
+func (x *CheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*CheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CheckPermissionResponse) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionResponse) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type Invite struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	CityId        string                 `protobuf:"bytes,2,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	InitiatorId   string                 `protobuf:"bytes,4,opt,name=initiator_id,json=initiatorId,proto3" json:"initiator_id,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Role          string                 `protobuf:"bytes,6,opt,name=role,proto3" json:"role,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Invite) Reset() {
+	*x = Invite{}
+	mi := &file_cities_v1_cities_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Invite) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invite) ProtoMessage() {}
+
+func (x *Invite) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invite.ProtoReflect.Descriptor instead.
+func (*Invite) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *Invite) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Invite) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *Invite) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Invite) GetInitiatorId() string {
+	if x != nil {
+		return x.InitiatorId
+	}
+	return ""
+}
+
+func (x *Invite) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Invite) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+func (x *Invite) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *Invite) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type GetInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteRequest) Reset() {
+	*x = GetInviteRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteRequest) ProtoMessage() {}
+
+func (x *GetInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetInviteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type ListPendingInvitesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesRequest) Reset() {
+	*x = ListPendingInvitesRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesRequest) ProtoMessage() {}
+
+func (x *ListPendingInvitesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ListPendingInvitesRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ListPendingInvitesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Invites       []*Invite              `protobuf:"bytes,1,rep,name=invites,proto3" json:"invites,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPendingInvitesResponse) Reset() {
+	*x = ListPendingInvitesResponse{}
+	mi := &file_cities_v1_cities_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPendingInvitesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPendingInvitesResponse) ProtoMessage() {}
+
+func (x *ListPendingInvitesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPendingInvitesResponse.ProtoReflect.Descriptor instead.
+func (*ListPendingInvitesResponse) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListPendingInvitesResponse) GetInvites() []*Invite {
+	if x != nil {
+		return x.Invites
+	}
+	return nil
+}
+
+type CreateInviteRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CityId        string                 `protobuf:"bytes,1,opt,name=city_id,json=cityId,proto3" json:"city_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteRequest) Reset() {
+	*x = CreateInviteRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteRequest) ProtoMessage() {}
+
+func (x *CreateInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateInviteRequest) GetCityId() string {
+	if x != nil {
+		return x.CityId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CreateInviteRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type ReplyInviteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// "accepted" or "declined"
+	Answer        string `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplyInviteRequest) Reset() {
+	*x = ReplyInviteRequest{}
+	mi := &file_cities_v1_cities_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplyInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplyInviteRequest) ProtoMessage() {}
+
+func (x *ReplyInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cities_v1_cities_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplyInviteRequest.ProtoReflect.Descriptor instead.
+func (*ReplyInviteRequest) Descriptor() ([]byte, []int) {
+	return file_cities_v1_cities_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ReplyInviteRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ReplyInviteRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+var File_cities_v1_cities_proto protoreflect.FileDescriptor
+
+const file_cities_v1_cities_proto_rawDesc = "" +
+	"\n" +
+	"\x16cities/v1/cities.proto\x12\tcities.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"A\n" +
+	"\x05Point\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xdf\x02\n" +
+	"\x04City\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"country_id\x18\x02 \x01(\tR\tcountryId\x12&\n" +
+	"\x05point\x18\x03 \x01(\v2\x10.cities.v1.PointR\x05point\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x12\n" +
+	"\x04name\x18\x05 \x01(\tR\x04name\x12\x17\n" +
+	"\x04icon\x18\x06 \x01(\tH\x00R\x04icon\x88\x01\x01\x12\x17\n" +
+	"\x04slug\x18\a \x01(\tH\x01R\x04slug\x88\x01\x01\x12\x1a\n" +
+	"\btimezone\x18\b \x01(\tR\btimezone\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\a\n" +
+	"\x05_iconB\a\n" +
+	"\x05_slug\"\x9a\x02\n" +
+	"\tCityAdmin\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\x12\x19\n" +
+	"\x05label\x18\x04 \x01(\tH\x00R\x05label\x88\x01\x01\x12\x1f\n" +
+	"\bposition\x18\x05 \x01(\tH\x01R\bposition\x88\x01\x01\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB\b\n" +
+	"\x06_labelB\v\n" +
+	"\t_position\" \n" +
+	"\x0eGetCityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\")\n" +
+	"\x15BatchGetCitiesRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\tR\x03ids\"^\n" +
+	"\x16BatchGetCitiesResponse\x12'\n" +
+	"\x06cities\x18\x01 \x03(\v2\x0f.cities.v1.CityR\x06cities\x12\x1b\n" +
+	"\tnot_found\x18\x02 \x03(\tR\bnotFound\"V\n" +
+	"\x11LocateCityRequest\x12&\n" +
+	"\x05point\x18\x01 \x01(\v2\x10.cities.v1.PointR\x05point\x12\x19\n" +
+	"\bradius_m\x18\x02 \x01(\x04R\aradiusM\"G\n" +
+	"\x13GetCityAdminRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\"`\n" +
+	"\x16CheckPermissionRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05roles\x18\x03 \x03(\tR\x05roles\"G\n" +
+	"\x17CheckPermissionResponse\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"\x8f\x02\n" +
+	"\x06Invite\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x17\n" +
+	"\acity_id\x18\x02 \x01(\tR\x06cityId\x12\x17\n" +
+	"\auser_id\x18\x03 \x01(\tR\x06userId\x12!\n" +
+	"\finitiator_id\x18\x04 \x01(\tR\vinitiatorId\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12\x12\n" +
+	"\x04role\x18\x06 \x01(\tR\x04role\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\"\n" +
+	"\x10GetInviteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"4\n" +
+	"\x19ListPendingInvitesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"I\n" +
+	"\x1aListPendingInvitesResponse\x12+\n" +
+	"\ainvites\x18\x01 \x03(\v2\x11.cities.v1.InviteR\ainvites\"[\n" +
+	"\x13CreateInviteRequest\x12\x17\n" +
+	"\acity_id\x18\x01 \x01(\tR\x06cityId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"<\n" +
+	"\x12ReplyInviteRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer2\x9e\x05\n" +
+	"\rCitiesService\x125\n" +
+	"\aGetCity\x12\x19.cities.v1.GetCityRequest\x1a\x0f.cities.v1.City\x12U\n" +
+	"\x0eBatchGetCities\x12 .cities.v1.BatchGetCitiesRequest\x1a!.cities.v1.BatchGetCitiesResponse\x12;\n" +
+	"\n" +
+	"LocateCity\x12\x1c.cities.v1.LocateCityRequest\x1a\x0f.cities.v1.City\x12D\n" +
+	"\fGetCityAdmin\x12\x1e.cities.v1.GetCityAdminRequest\x1a\x14.cities.v1.CityAdmin\x12X\n" +
+	"\x0fCheckPermission\x12!.cities.v1.CheckPermissionRequest\x1a\".cities.v1.CheckPermissionResponse\x12;\n" +
+	"\tGetInvite\x12\x1b.cities.v1.GetInviteRequest\x1a\x11.cities.v1.Invite\x12a\n" +
+	"\x12ListPendingInvites\x12$.cities.v1.ListPendingInvitesRequest\x1a%.cities.v1.ListPendingInvitesResponse\x12A\n" +
+	"\fCreateInvite\x12\x1e.cities.v1.CreateInviteRequest\x1a\x11.cities.v1.Invite\x12?\n" +
+	"\vReplyInvite\x12\x1d.cities.v1.ReplyInviteRequest\x1a\x11.cities.v1.InviteB;Z9github.com/chains-lab/cities-svc/proto/cities/v1;citiesv1b\x06proto3"
+
+var (
+	file_cities_v1_cities_proto_rawDescOnce sync.Once
+	file_cities_v1_cities_proto_rawDescData []byte
+)
+
+func file_cities_v1_cities_proto_rawDescGZIP() []byte {
+	file_cities_v1_cities_proto_rawDescOnce.Do(func() {
+		file_cities_v1_cities_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cities_v1_cities_proto_rawDesc), len(file_cities_v1_cities_proto_rawDesc)))
+	})
+	return file_cities_v1_cities_proto_rawDescData
+}
+
+var file_cities_v1_cities_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_cities_v1_cities_proto_goTypes = []any{
+	(*Point)(nil),                      // 0: cities.v1.Point
+	(*City)(nil),                       // 1: cities.v1.City
+	(*CityAdmin)(nil),                  // 2: cities.v1.CityAdmin
+	(*GetCityRequest)(nil),             // 3: cities.v1.GetCityRequest
+	(*BatchGetCitiesRequest)(nil),      // 4: cities.v1.BatchGetCitiesRequest
+	(*BatchGetCitiesResponse)(nil),     // 5: cities.v1.BatchGetCitiesResponse
+	(*LocateCityRequest)(nil),          // 6: cities.v1.LocateCityRequest
+	(*GetCityAdminRequest)(nil),        // 7: cities.v1.GetCityAdminRequest
+	(*CheckPermissionRequest)(nil),     // 8: cities.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),    // 9: cities.v1.CheckPermissionResponse
+	(*Invite)(nil),                     // 10: cities.v1.Invite
+	(*GetInviteRequest)(nil),           // 11: cities.v1.GetInviteRequest
+	(*ListPendingInvitesRequest)(nil),  // 12: cities.v1.ListPendingInvitesRequest
+	(*ListPendingInvitesResponse)(nil), // 13: cities.v1.ListPendingInvitesResponse
+	(*CreateInviteRequest)(nil),        // 14: cities.v1.CreateInviteRequest
+	(*ReplyInviteRequest)(nil),         // 15: cities.v1.ReplyInviteRequest
+	(*timestamppb.Timestamp)(nil),      // 16: google.protobuf.Timestamp
+}
+var file_cities_v1_cities_proto_depIdxs = []int32{
+	0,  // 0: cities.v1.City.point:type_name -> cities.v1.Point
+	16, // 1: cities.v1.City.created_at:type_name -> google.protobuf.Timestamp
+	16, // 2: cities.v1.City.updated_at:type_name -> google.protobuf.Timestamp
+	16, // 3: cities.v1.CityAdmin.created_at:type_name -> google.protobuf.Timestamp
+	16, // 4: cities.v1.CityAdmin.updated_at:type_name -> google.protobuf.Timestamp
+	1,  // 5: cities.v1.BatchGetCitiesResponse.cities:type_name -> cities.v1.City
+	0,  // 6: cities.v1.LocateCityRequest.point:type_name -> cities.v1.Point
+	16, // 7: cities.v1.Invite.expires_at:type_name -> google.protobuf.Timestamp
+	16, // 8: cities.v1.Invite.created_at:type_name -> google.protobuf.Timestamp
+	10, // 9: cities.v1.ListPendingInvitesResponse.invites:type_name -> cities.v1.Invite
+	3,  // 10: cities.v1.CitiesService.GetCity:input_type -> cities.v1.GetCityRequest
+	4,  // 11: cities.v1.CitiesService.BatchGetCities:input_type -> cities.v1.BatchGetCitiesRequest
+	6,  // 12: cities.v1.CitiesService.LocateCity:input_type -> cities.v1.LocateCityRequest
+	7,  // 13: cities.v1.CitiesService.GetCityAdmin:input_type -> cities.v1.GetCityAdminRequest
+	8,  // 14: cities.v1.CitiesService.CheckPermission:input_type -> cities.v1.CheckPermissionRequest
+	11, // 15: cities.v1.CitiesService.GetInvite:input_type -> cities.v1.GetInviteRequest
+	12, // 16: cities.v1.CitiesService.ListPendingInvites:input_type -> cities.v1.ListPendingInvitesRequest
+	14, // 17: cities.v1.CitiesService.CreateInvite:input_type -> cities.v1.CreateInviteRequest
+	15, // 18: cities.v1.CitiesService.ReplyInvite:input_type -> cities.v1.ReplyInviteRequest
+	1,  // 19: cities.v1.CitiesService.GetCity:output_type -> cities.v1.City
+	5,  // 20: cities.v1.CitiesService.BatchGetCities:output_type -> cities.v1.BatchGetCitiesResponse
+	1,  // 21: cities.v1.CitiesService.LocateCity:output_type -> cities.v1.City
+	2,  // 22: cities.v1.CitiesService.GetCityAdmin:output_type -> cities.v1.CityAdmin
+	9,  // 23: cities.v1.CitiesService.CheckPermission:output_type -> cities.v1.CheckPermissionResponse
+	10, // 24: cities.v1.CitiesService.GetInvite:output_type -> cities.v1.Invite
+	13, // 25: cities.v1.CitiesService.ListPendingInvites:output_type -> cities.v1.ListPendingInvitesResponse
+	10, // 26: cities.v1.CitiesService.CreateInvite:output_type -> cities.v1.Invite
+	10, // 27: cities.v1.CitiesService.ReplyInvite:output_type -> cities.v1.Invite
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_cities_v1_cities_proto_init() }
+func file_cities_v1_cities_proto_init() {
+	if File_cities_v1_cities_proto != nil {
+		return
+	}
+	file_cities_v1_cities_proto_msgTypes[1].OneofWrappers = []any{}
+	file_cities_v1_cities_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cities_v1_cities_proto_rawDesc), len(file_cities_v1_cities_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   16,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_cities_v1_cities_proto_goTypes,
+		DependencyIndexes: file_cities_v1_cities_proto_depIdxs,
+		MessageInfos:      file_cities_v1_cities_proto_msgTypes,
+	}.Build()
+	File_cities_v1_cities_proto = out.File
+	file_cities_v1_cities_proto_goTypes = nil
+	file_cities_v1_cities_proto_depIdxs = nil
+}
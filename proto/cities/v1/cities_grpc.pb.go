@@ -0,0 +1,445 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: cities/v1/cities.proto
+
+package citiesv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	CitiesService_GetCity_FullMethodName            = "/cities.v1.CitiesService/GetCity"
+	CitiesService_BatchGetCities_FullMethodName     = "/cities.v1.CitiesService/BatchGetCities"
+	CitiesService_LocateCity_FullMethodName         = "/cities.v1.CitiesService/LocateCity"
+	CitiesService_GetCityAdmin_FullMethodName       = "/cities.v1.CitiesService/GetCityAdmin"
+	CitiesService_CheckPermission_FullMethodName    = "/cities.v1.CitiesService/CheckPermission"
+	CitiesService_GetInvite_FullMethodName          = "/cities.v1.CitiesService/GetInvite"
+	CitiesService_ListPendingInvites_FullMethodName = "/cities.v1.CitiesService/ListPendingInvites"
+	CitiesService_CreateInvite_FullMethodName       = "/cities.v1.CitiesService/CreateInvite"
+	CitiesService_ReplyInvite_FullMethodName        = "/cities.v1.CitiesService/ReplyInvite"
+)
+
+// CitiesServiceClient is the client API for CitiesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// CitiesService is the internal API for other chains-lab services.
+// Every call must carry a user access token in the "authorization" metadata.
+type CitiesServiceClient interface {
+	GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*City, error)
+	BatchGetCities(ctx context.Context, in *BatchGetCitiesRequest, opts ...grpc.CallOption) (*BatchGetCitiesResponse, error)
+	// LocateCity returns the nearest city within radius_m of the point.
+	LocateCity(ctx context.Context, in *LocateCityRequest, opts ...grpc.CallOption) (*City, error)
+	GetCityAdmin(ctx context.Context, in *GetCityAdminRequest, opts ...grpc.CallOption) (*CityAdmin, error)
+	// CheckPermission reports whether the user is an admin of the city with one of the given roles,
+	// any role is accepted if roles is empty. Fails with NOT_FOUND if the city does not exist.
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error)
+	GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	// ListPendingInvites returns the unanswered, unexpired invites addressed to the user.
+	ListPendingInvites(ctx context.Context, in *ListPendingInvitesRequest, opts ...grpc.CallOption) (*ListPendingInvitesResponse, error)
+	// CreateInvite invites a user to administer a city on behalf of the caller, a system admin or
+	// an admin of the city.
+	CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+	// ReplyInvite accepts or declines an invite addressed to the caller.
+	ReplyInvite(ctx context.Context, in *ReplyInviteRequest, opts ...grpc.CallOption) (*Invite, error)
+}
+
+type citiesServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCitiesServiceClient(cc grpc.ClientConnInterface) CitiesServiceClient {
+	return &citiesServiceClient{cc}
+}
+
+func (c *citiesServiceClient) GetCity(ctx context.Context, in *GetCityRequest, opts ...grpc.CallOption) (*City, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(City)
+	err := c.cc.Invoke(ctx, CitiesService_GetCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) BatchGetCities(ctx context.Context, in *BatchGetCitiesRequest, opts ...grpc.CallOption) (*BatchGetCitiesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchGetCitiesResponse)
+	err := c.cc.Invoke(ctx, CitiesService_BatchGetCities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) LocateCity(ctx context.Context, in *LocateCityRequest, opts ...grpc.CallOption) (*City, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(City)
+	err := c.cc.Invoke(ctx, CitiesService_LocateCity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) GetCityAdmin(ctx context.Context, in *GetCityAdminRequest, opts ...grpc.CallOption) (*CityAdmin, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CityAdmin)
+	err := c.cc.Invoke(ctx, CitiesService_GetCityAdmin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionResponse)
+	err := c.cc.Invoke(ctx, CitiesService_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) GetInvite(ctx context.Context, in *GetInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, CitiesService_GetInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) ListPendingInvites(ctx context.Context, in *ListPendingInvitesRequest, opts ...grpc.CallOption) (*ListPendingInvitesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPendingInvitesResponse)
+	err := c.cc.Invoke(ctx, CitiesService_ListPendingInvites_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) CreateInvite(ctx context.Context, in *CreateInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, CitiesService_CreateInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *citiesServiceClient) ReplyInvite(ctx context.Context, in *ReplyInviteRequest, opts ...grpc.CallOption) (*Invite, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Invite)
+	err := c.cc.Invoke(ctx, CitiesService_ReplyInvite_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CitiesServiceServer is the server API for CitiesService service.
+// All implementations must embed UnimplementedCitiesServiceServer
+// for forward compatibility.
+//
+// CitiesService is the internal API for other chains-lab services.
+// Every call must carry a user access token in the "authorization" metadata.
+type CitiesServiceServer interface {
+	GetCity(context.Context, *GetCityRequest) (*City, error)
+	BatchGetCities(context.Context, *BatchGetCitiesRequest) (*BatchGetCitiesResponse, error)
+	// LocateCity returns the nearest city within radius_m of the point.
+	LocateCity(context.Context, *LocateCityRequest) (*City, error)
+	GetCityAdmin(context.Context, *GetCityAdminRequest) (*CityAdmin, error)
+	// CheckPermission reports whether the user is an admin of the city with one of the given roles,
+	// any role is accepted if roles is empty. Fails with NOT_FOUND if the city does not exist.
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error)
+	GetInvite(context.Context, *GetInviteRequest) (*Invite, error)
+	// ListPendingInvites returns the unanswered, unexpired invites addressed to the user.
+	ListPendingInvites(context.Context, *ListPendingInvitesRequest) (*ListPendingInvitesResponse, error)
+	// CreateInvite invites a user to administer a city on behalf of the caller, a system admin or
+	// an admin of the city.
+	CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error)
+	// ReplyInvite accepts or declines an invite addressed to the caller.
+	ReplyInvite(context.Context, *ReplyInviteRequest) (*Invite, error)
+	mustEmbedUnimplementedCitiesServiceServer()
+}
+
+// UnimplementedCitiesServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCitiesServiceServer struct{}
+
+func (UnimplementedCitiesServiceServer) GetCity(context.Context, *GetCityRequest) (*City, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCity not implemented")
+}
+func (UnimplementedCitiesServiceServer) BatchGetCities(context.Context, *BatchGetCitiesRequest) (*BatchGetCitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchGetCities not implemented")
+}
+func (UnimplementedCitiesServiceServer) LocateCity(context.Context, *LocateCityRequest) (*City, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LocateCity not implemented")
+}
+func (UnimplementedCitiesServiceServer) GetCityAdmin(context.Context, *GetCityAdminRequest) (*CityAdmin, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCityAdmin not implemented")
+}
+func (UnimplementedCitiesServiceServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckPermission not implemented")
+}
+func (UnimplementedCitiesServiceServer) GetInvite(context.Context, *GetInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInvite not implemented")
+}
+func (UnimplementedCitiesServiceServer) ListPendingInvites(context.Context, *ListPendingInvitesRequest) (*ListPendingInvitesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingInvites not implemented")
+}
+func (UnimplementedCitiesServiceServer) CreateInvite(context.Context, *CreateInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvite not implemented")
+}
+func (UnimplementedCitiesServiceServer) ReplyInvite(context.Context, *ReplyInviteRequest) (*Invite, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplyInvite not implemented")
+}
+func (UnimplementedCitiesServiceServer) mustEmbedUnimplementedCitiesServiceServer() {}
+func (UnimplementedCitiesServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCitiesServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CitiesServiceServer will
+// result in compilation errors.
+type UnsafeCitiesServiceServer interface {
+	mustEmbedUnimplementedCitiesServiceServer()
+}
+
+func RegisterCitiesServiceServer(s grpc.ServiceRegistrar, srv CitiesServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCitiesServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CitiesService_ServiceDesc, srv)
+}
+
+func _CitiesService_GetCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).GetCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_GetCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).GetCity(ctx, req.(*GetCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_BatchGetCities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchGetCitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).BatchGetCities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_BatchGetCities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).BatchGetCities(ctx, req.(*BatchGetCitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_LocateCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LocateCityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).LocateCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_LocateCity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).LocateCity(ctx, req.(*LocateCityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_GetCityAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCityAdminRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).GetCityAdmin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_GetCityAdmin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).GetCityAdmin(ctx, req.(*GetCityAdminRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_GetInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).GetInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_GetInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).GetInvite(ctx, req.(*GetInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_ListPendingInvites_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingInvitesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).ListPendingInvites(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_ListPendingInvites_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).ListPendingInvites(ctx, req.(*ListPendingInvitesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_CreateInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).CreateInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_CreateInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).CreateInvite(ctx, req.(*CreateInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CitiesService_ReplyInvite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplyInviteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CitiesServiceServer).ReplyInvite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CitiesService_ReplyInvite_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CitiesServiceServer).ReplyInvite(ctx, req.(*ReplyInviteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CitiesService_ServiceDesc is the grpc.ServiceDesc for CitiesService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CitiesService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "cities.v1.CitiesService",
+	HandlerType: (*CitiesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCity",
+			Handler:    _CitiesService_GetCity_Handler,
+		},
+		{
+			MethodName: "BatchGetCities",
+			Handler:    _CitiesService_BatchGetCities_Handler,
+		},
+		{
+			MethodName: "LocateCity",
+			Handler:    _CitiesService_LocateCity_Handler,
+		},
+		{
+			MethodName: "GetCityAdmin",
+			Handler:    _CitiesService_GetCityAdmin_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _CitiesService_CheckPermission_Handler,
+		},
+		{
+			MethodName: "GetInvite",
+			Handler:    _CitiesService_GetInvite_Handler,
+		},
+		{
+			MethodName: "ListPendingInvites",
+			Handler:    _CitiesService_ListPendingInvites_Handler,
+		},
+		{
+			MethodName: "CreateInvite",
+			Handler:    _CitiesService_CreateInvite_Handler,
+		},
+		{
+			MethodName: "ReplyInvite",
+			Handler:    _CitiesService_ReplyInvite_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cities/v1/cities.proto",
+}
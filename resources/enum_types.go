@@ -6,4 +6,11 @@ const (
 	CityInviteType = "city_invite"
 
 	InviteType = "invite"
+
+	ProfileType = "profile"
+
+	CitiesBatchType     = "cities_batch"
+	CityAdminsBatchType = "city_admins_batch"
+
+	AdminMembershipsType = "admin_memberships"
 )
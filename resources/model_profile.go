@@ -16,38 +16,38 @@ import (
 	"fmt"
 )
 
-// checks if the ReplyToInvite type satisfies the MappedNullable interface at compile time
-var _ MappedNullable = &ReplyToInvite{}
+// checks if the Profile type satisfies the MappedNullable interface at compile time
+var _ MappedNullable = &Profile{}
 
-// ReplyToInvite struct for ReplyToInvite
-type ReplyToInvite struct {
-	Data ReplyToInviteData `json:"data"`
+// Profile struct for Profile
+type Profile struct {
+	Data ProfileData `json:"data"`
 }
 
-type _ReplyToInvite ReplyToInvite
+type _Profile Profile
 
-// NewReplyToInvite instantiates a new ReplyToInvite object
+// NewProfile instantiates a new Profile object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewReplyToInvite(data ReplyToInviteData) *ReplyToInvite {
-	this := ReplyToInvite{}
+func NewProfile(data ProfileData) *Profile {
+	this := Profile{}
 	this.Data = data
 	return &this
 }
 
-// NewReplyToInviteWithDefaults instantiates a new ReplyToInvite object
+// NewProfileWithDefaults instantiates a new Profile object
 // This constructor will only assign default values to properties that have it defined,
 // but it doesn't guarantee that properties required by API are set
-func NewReplyToInviteWithDefaults() *ReplyToInvite {
-	this := ReplyToInvite{}
+func NewProfileWithDefaults() *Profile {
+	this := Profile{}
 	return &this
 }
 
 // GetData returns the Data field value
-func (o *ReplyToInvite) GetData() ReplyToInviteData {
+func (o *Profile) GetData() ProfileData {
 	if o == nil {
-		var ret ReplyToInviteData
+		var ret ProfileData
 		return ret
 	}
 
@@ -56,7 +56,7 @@ func (o *ReplyToInvite) GetData() ReplyToInviteData {
 
 // GetDataOk returns a tuple with the Data field value
 // and a boolean to check if the value has been set.
-func (o *ReplyToInvite) GetDataOk() (*ReplyToInviteData, bool) {
+func (o *Profile) GetDataOk() (*ProfileData, bool) {
 	if o == nil {
 		return nil, false
 	}
@@ -64,11 +64,11 @@ func (o *ReplyToInvite) GetDataOk() (*ReplyToInviteData, bool) {
 }
 
 // SetData sets field value
-func (o *ReplyToInvite) SetData(v ReplyToInviteData) {
+func (o *Profile) SetData(v ProfileData) {
 	o.Data = v
 }
 
-func (o ReplyToInvite) MarshalJSON() ([]byte, error) {
+func (o Profile) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
 		return []byte{}, err
@@ -76,13 +76,13 @@ func (o ReplyToInvite) MarshalJSON() ([]byte, error) {
 	return json.Marshal(toSerialize)
 }
 
-func (o ReplyToInvite) ToMap() (map[string]interface{}, error) {
+func (o Profile) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["data"] = o.Data
 	return toSerialize, nil
 }
 
-func (o *ReplyToInvite) UnmarshalJSON(data []byte) (err error) {
+func (o *Profile) UnmarshalJSON(data []byte) (err error) {
 	// This validates that all required properties are included in the JSON object
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
@@ -104,53 +104,53 @@ func (o *ReplyToInvite) UnmarshalJSON(data []byte) (err error) {
 		}
 	}
 
-	varReplyToInvite := _ReplyToInvite{}
+	varProfile := _Profile{}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	decoder.DisallowUnknownFields()
-	err = decoder.Decode(&varReplyToInvite)
+	err = decoder.Decode(&varProfile)
 
 	if err != nil {
 		return err
 	}
 
-	*o = ReplyToInvite(varReplyToInvite)
+	*o = Profile(varProfile)
 
 	return err
 }
 
-type NullableReplyToInvite struct {
-	value *ReplyToInvite
+type NullableProfile struct {
+	value *Profile
 	isSet bool
 }
 
-func (v NullableReplyToInvite) Get() *ReplyToInvite {
+func (v NullableProfile) Get() *Profile {
 	return v.value
 }
 
-func (v *NullableReplyToInvite) Set(val *ReplyToInvite) {
+func (v *NullableProfile) Set(val *Profile) {
 	v.value = val
 	v.isSet = true
 }
 
-func (v NullableReplyToInvite) IsSet() bool {
+func (v NullableProfile) IsSet() bool {
 	return v.isSet
 }
 
-func (v *NullableReplyToInvite) Unset() {
+func (v *NullableProfile) Unset() {
 	v.value = nil
 	v.isSet = false
 }
 
-func NewNullableReplyToInvite(val *ReplyToInvite) *NullableReplyToInvite {
-	return &NullableReplyToInvite{value: val, isSet: true}
+func NewNullableProfile(val *Profile) *NullableProfile {
+	return &NullableProfile{value: val, isSet: true}
 }
 
-func (v NullableReplyToInvite) MarshalJSON() ([]byte, error) {
+func (v NullableProfile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(v.value)
 }
 
-func (v *NullableReplyToInvite) UnmarshalJSON(src []byte) error {
+func (v *NullableProfile) UnmarshalJSON(src []byte) error {
 	v.isSet = true
 	return json.Unmarshal(src, &v.value)
 }
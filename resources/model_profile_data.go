@@ -17,41 +17,41 @@ import (
 	"fmt"
 )
 
-// checks if the ReplyToInviteData type satisfies the MappedNullable interface at compile time
-var _ MappedNullable = &ReplyToInviteData{}
+// checks if the ProfileData type satisfies the MappedNullable interface at compile time
+var _ MappedNullable = &ProfileData{}
 
-// ReplyToInviteData struct for ReplyToInviteData
-type ReplyToInviteData struct {
-	// invite id
+// ProfileData struct for ProfileData
+type ProfileData struct {
+	// user id
 	Id uuid.UUID `json:"id"`
 	Type string `json:"type"`
-	Attributes ReplyToInviteDataAttributes `json:"attributes"`
+	Attributes ProfileAttributes `json:"attributes"`
 }
 
-type _ReplyToInviteData ReplyToInviteData
+type _ProfileData ProfileData
 
-// NewReplyToInviteData instantiates a new ReplyToInviteData object
+// NewProfileData instantiates a new ProfileData object
 // This constructor will assign default values to properties that have it defined,
 // and makes sure properties required by API are set, but the set of arguments
 // will change when the set of required properties is changed
-func NewReplyToInviteData(id uuid.UUID, type_ string, attributes ReplyToInviteDataAttributes) *ReplyToInviteData {
-	this := ReplyToInviteData{}
+func NewProfileData(id uuid.UUID, type_ string, attributes ProfileAttributes) *ProfileData {
+	this := ProfileData{}
 	this.Id = id
 	this.Type = type_
 	this.Attributes = attributes
 	return &this
 }
 
-// NewReplyToInviteDataWithDefaults instantiates a new ReplyToInviteData object
+// NewProfileDataWithDefaults instantiates a new ProfileData object
 // This constructor will only assign default values to properties that have it defined,
 // but it doesn't guarantee that properties required by API are set
-func NewReplyToInviteDataWithDefaults() *ReplyToInviteData {
-	this := ReplyToInviteData{}
+func NewProfileDataWithDefaults() *ProfileData {
+	this := ProfileData{}
 	return &this
 }
 
 // GetId returns the Id field value
-func (o *ReplyToInviteData) GetId() uuid.UUID {
+func (o *ProfileData) GetId() uuid.UUID {
 	if o == nil {
 		var ret uuid.UUID
 		return ret
@@ -62,7 +62,7 @@ func (o *ReplyToInviteData) GetId() uuid.UUID {
 
 // GetIdOk returns a tuple with the Id field value
 // and a boolean to check if the value has been set.
-func (o *ReplyToInviteData) GetIdOk() (*uuid.UUID, bool) {
+func (o *ProfileData) GetIdOk() (*uuid.UUID, bool) {
 	if o == nil {
 		return nil, false
 	}
@@ -70,12 +70,12 @@ func (o *ReplyToInviteData) GetIdOk() (*uuid.UUID, bool) {
 }
 
 // SetId sets field value
-func (o *ReplyToInviteData) SetId(v uuid.UUID) {
+func (o *ProfileData) SetId(v uuid.UUID) {
 	o.Id = v
 }
 
 // GetType returns the Type field value
-func (o *ReplyToInviteData) GetType() string {
+func (o *ProfileData) GetType() string {
 	if o == nil {
 		var ret string
 		return ret
@@ -86,7 +86,7 @@ func (o *ReplyToInviteData) GetType() string {
 
 // GetTypeOk returns a tuple with the Type field value
 // and a boolean to check if the value has been set.
-func (o *ReplyToInviteData) GetTypeOk() (*string, bool) {
+func (o *ProfileData) GetTypeOk() (*string, bool) {
 	if o == nil {
 		return nil, false
 	}
@@ -94,14 +94,14 @@ func (o *ReplyToInviteData) GetTypeOk() (*string, bool) {
 }
 
 // SetType sets field value
-func (o *ReplyToInviteData) SetType(v string) {
+func (o *ProfileData) SetType(v string) {
 	o.Type = v
 }
 
 // GetAttributes returns the Attributes field value
-func (o *ReplyToInviteData) GetAttributes() ReplyToInviteDataAttributes {
+func (o *ProfileData) GetAttributes() ProfileAttributes {
 	if o == nil {
-		var ret ReplyToInviteDataAttributes
+		var ret ProfileAttributes
 		return ret
 	}
 
@@ -110,7 +110,7 @@ func (o *ReplyToInviteData) GetAttributes() ReplyToInviteDataAttributes {
 
 // GetAttributesOk returns a tuple with the Attributes field value
 // and a boolean to check if the value has been set.
-func (o *ReplyToInviteData) GetAttributesOk() (*ReplyToInviteDataAttributes, bool) {
+func (o *ProfileData) GetAttributesOk() (*ProfileAttributes, bool) {
 	if o == nil {
 		return nil, false
 	}
@@ -118,11 +118,11 @@ func (o *ReplyToInviteData) GetAttributesOk() (*ReplyToInviteDataAttributes, boo
 }
 
 // SetAttributes sets field value
-func (o *ReplyToInviteData) SetAttributes(v ReplyToInviteDataAttributes) {
+func (o *ProfileData) SetAttributes(v ProfileAttributes) {
 	o.Attributes = v
 }
 
-func (o ReplyToInviteData) MarshalJSON() ([]byte, error) {
+func (o ProfileData) MarshalJSON() ([]byte, error) {
 	toSerialize,err := o.ToMap()
 	if err != nil {
 		return []byte{}, err
@@ -130,7 +130,7 @@ func (o ReplyToInviteData) MarshalJSON() ([]byte, error) {
 	return json.Marshal(toSerialize)
 }
 
-func (o ReplyToInviteData) ToMap() (map[string]interface{}, error) {
+func (o ProfileData) ToMap() (map[string]interface{}, error) {
 	toSerialize := map[string]interface{}{}
 	toSerialize["id"] = o.Id
 	toSerialize["type"] = o.Type
@@ -138,7 +138,7 @@ func (o ReplyToInviteData) ToMap() (map[string]interface{}, error) {
 	return toSerialize, nil
 }
 
-func (o *ReplyToInviteData) UnmarshalJSON(data []byte) (err error) {
+func (o *ProfileData) UnmarshalJSON(data []byte) (err error) {
 	// This validates that all required properties are included in the JSON object
 	// by unmarshalling the object into a generic map with string keys and checking
 	// that every required field exists as a key in the generic map.
@@ -162,53 +162,53 @@ func (o *ReplyToInviteData) UnmarshalJSON(data []byte) (err error) {
 		}
 	}
 
-	varReplyToInviteData := _ReplyToInviteData{}
+	varProfileData := _ProfileData{}
 
 	decoder := json.NewDecoder(bytes.NewReader(data))
 	decoder.DisallowUnknownFields()
-	err = decoder.Decode(&varReplyToInviteData)
+	err = decoder.Decode(&varProfileData)
 
 	if err != nil {
 		return err
 	}
 
-	*o = ReplyToInviteData(varReplyToInviteData)
+	*o = ProfileData(varProfileData)
 
 	return err
 }
 
-type NullableReplyToInviteData struct {
-	value *ReplyToInviteData
+type NullableProfileData struct {
+	value *ProfileData
 	isSet bool
 }
 
-func (v NullableReplyToInviteData) Get() *ReplyToInviteData {
+func (v NullableProfileData) Get() *ProfileData {
 	return v.value
 }
 
-func (v *NullableReplyToInviteData) Set(val *ReplyToInviteData) {
+func (v *NullableProfileData) Set(val *ProfileData) {
 	v.value = val
 	v.isSet = true
 }
 
-func (v NullableReplyToInviteData) IsSet() bool {
+func (v NullableProfileData) IsSet() bool {
 	return v.isSet
 }
 
-func (v *NullableReplyToInviteData) Unset() {
+func (v *NullableProfileData) Unset() {
 	v.value = nil
 	v.isSet = false
 }
 
-func NewNullableReplyToInviteData(val *ReplyToInviteData) *NullableReplyToInviteData {
-	return &NullableReplyToInviteData{value: val, isSet: true}
+func NewNullableProfileData(val *ProfileData) *NullableProfileData {
+	return &NullableProfileData{value: val, isSet: true}
 }
 
-func (v NullableReplyToInviteData) MarshalJSON() ([]byte, error) {
+func (v NullableProfileData) MarshalJSON() ([]byte, error) {
 	return json.Marshal(v.value)
 }
 
-func (v *NullableReplyToInviteData) UnmarshalJSON(src []byte) error {
+func (v *NullableProfileData) UnmarshalJSON(src []byte) error {
 	v.isSet = true
 	return json.Unmarshal(src, &v.value)
 }
@@ -0,0 +1,202 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/google/uuid"
+)
+
+func TestCreateAdminReplacesTechLead(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	first := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+	second := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+
+	lead, err := s.db.GetCityTechLead(ctx, kyiv.ID)
+	if err != nil {
+		t.Fatalf("get tech lead: %v", err)
+	}
+	if lead.UserID != second.UserID {
+		t.Errorf("tech lead = %s, want %s", lead.UserID, second.UserID)
+	}
+
+	if _, err = s.admin.Get(ctx, first.UserID, kyiv.ID); !errors.Is(err, errx.ErrorCityAdminNotFound) {
+		t.Errorf("expected previous tech lead to be removed, got %v", err)
+	}
+}
+
+func TestCreateAdminInSuspendedCity(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	if _, err := s.city.UpdateStatusBySysAdmin(ctx, kyiv.ID, enum.CityStatusSuspended); err != nil {
+		t.Fatalf("suspend city: %v", err)
+	}
+
+	_, err := s.admin.Create(ctx, uuid.New(), kyiv.ID, enum.CityAdminRoleMember)
+	if !errors.Is(err, errx.ErrorCityIsNotSupported) {
+		t.Fatalf("expected %v, got %v", errx.ErrorCityIsNotSupported, err)
+	}
+}
+
+func TestUpdateAdminByCityAdmin(t *testing.T) {
+	cases := []struct {
+		name      string
+		initiator string
+		target    string
+		params    admin.UpdateParams
+		want      error
+	}{
+		{name: "moderator labels member", initiator: enum.CityAdminRoleModerator, target: enum.CityAdminRoleMember, params: admin.UpdateParams{Label: ptr("press")}},
+		{name: "moderator cannot touch tech lead", initiator: enum.CityAdminRoleModerator, target: enum.CityAdminRoleTechLead, params: admin.UpdateParams{Label: ptr("x")}, want: errx.ErrorNotEnoughRight},
+		{name: "moderator cannot appoint chief", initiator: enum.CityAdminRoleModerator, target: enum.CityAdminRoleMember, params: admin.UpdateParams{Role: ptr(enum.CityAdminRoleChief)}, want: errx.ErrorNotEnoughRight},
+		{name: "member cannot update", initiator: enum.CityAdminRoleMember, target: enum.CityAdminRoleMember, params: admin.UpdateParams{Label: ptr("x")}, want: errx.ErrorNotEnoughRight},
+		{name: "tech lead appoints chief", initiator: enum.CityAdminRoleTechLead, target: enum.CityAdminRoleMember, params: admin.UpdateParams{Role: ptr(enum.CityAdminRoleChief)}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			kyiv := s.createCity(t, "Kyiv")
+			initiator := s.createAdmin(t, kyiv.ID, tc.initiator)
+			target := s.createAdmin(t, kyiv.ID, tc.target)
+
+			res, err := s.admin.UpdateByCityAdmin(ctx, initiator.UserID, target.UserID, kyiv.ID, tc.params)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("update: %v", err)
+			}
+
+			if tc.params.Role != nil && res.Role != *tc.params.Role {
+				t.Errorf("role = %s, want %s", res.Role, *tc.params.Role)
+			}
+			if tc.params.Label != nil && (res.Label == nil || *res.Label != *tc.params.Label) {
+				t.Errorf("label = %v, want %s", res.Label, *tc.params.Label)
+			}
+		})
+	}
+}
+
+func TestTechLeadHandover(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	lead := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+	moder := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+
+	_, err := s.admin.UpdateByCityAdmin(ctx, lead.UserID, moder.UserID, kyiv.ID, admin.UpdateParams{
+		Role: ptr(enum.CityAdminRoleTechLead),
+	})
+	if err != nil {
+		t.Fatalf("handover: %v", err)
+	}
+
+	newLead, err := s.db.GetCityTechLead(ctx, kyiv.ID)
+	if err != nil {
+		t.Fatalf("get tech lead: %v", err)
+	}
+	if newLead.UserID != moder.UserID {
+		t.Errorf("tech lead = %s, want %s", newLead.UserID, moder.UserID)
+	}
+
+	old, err := s.admin.Get(ctx, lead.UserID, kyiv.ID)
+	if err != nil {
+		t.Fatalf("get previous tech lead: %v", err)
+	}
+	if old.Role != enum.CityAdminRoleModerator {
+		t.Errorf("previous tech lead role = %s, want %s", old.Role, enum.CityAdminRoleModerator)
+	}
+}
+
+func TestDeleteAdminByCityAdmin(t *testing.T) {
+	cases := []struct {
+		name      string
+		initiator string
+		target    string
+		self      bool
+		want      error
+	}{
+		{name: "moderator deletes member", initiator: enum.CityAdminRoleModerator, target: enum.CityAdminRoleMember},
+		{name: "tech lead deletes moderator", initiator: enum.CityAdminRoleTechLead, target: enum.CityAdminRoleModerator},
+		{name: "nobody deletes tech lead", initiator: enum.CityAdminRoleModerator, target: enum.CityAdminRoleTechLead, want: errx.ErrorNotEnoughRight},
+		{name: "member cannot delete", initiator: enum.CityAdminRoleMember, target: enum.CityAdminRoleMember, want: errx.ErrorNotEnoughRight},
+		{name: "cannot delete yourself", initiator: enum.CityAdminRoleModerator, self: true, want: errx.ErrorCannotDeleteYourself},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			kyiv := s.createCity(t, "Kyiv")
+			initiator := s.createAdmin(t, kyiv.ID, tc.initiator)
+			target := initiator
+			if !tc.self {
+				target = s.createAdmin(t, kyiv.ID, tc.target)
+			}
+
+			err := s.admin.DeleteByCityAdmin(ctx, initiator.UserID, target.UserID, kyiv.ID)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("delete: %v", err)
+			}
+
+			if _, err = s.admin.Get(ctx, target.UserID, kyiv.ID); !errors.Is(err, errx.ErrorCityAdminNotFound) {
+				t.Errorf("expected admin to be deleted, got %v", err)
+			}
+		})
+	}
+}
+
+func TestGetCityAdminsByKeys(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	lviv := s.createCity(t, "Lviv")
+	moderator := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+	techLead := s.createAdmin(t, lviv.ID, enum.CityAdminRoleTechLead)
+
+	moderatorKey := admin.Key{UserID: moderator.UserID, CityID: kyiv.ID}
+	techLeadKey := admin.Key{UserID: techLead.UserID, CityID: lviv.ID}
+	// the moderator is an admin, but not of Lviv
+	otherCity := admin.Key{UserID: moderator.UserID, CityID: lviv.ID}
+
+	admins, notFound, err := s.admin.GetByKeys(ctx, []admin.Key{techLeadKey, otherCity, moderatorKey, techLeadKey, otherCity})
+	if err != nil {
+		t.Fatalf("get by keys: %v", err)
+	}
+
+	if len(admins) != 2 || admins[0].UserID != techLead.UserID || admins[1].UserID != moderator.UserID {
+		t.Errorf("admins = %v, want the tech lead and the moderator once each in request order", admins)
+	}
+	if len(notFound) != 1 || notFound[0] != otherCity {
+		t.Errorf("not found = %v, want [%v]", notFound, otherCity)
+	}
+
+	admins, notFound, err = s.admin.GetByKeys(ctx, nil)
+	if err != nil || len(admins) != 0 || len(notFound) != 0 {
+		t.Errorf("no keys: got %v, %v, %v", admins, notFound, err)
+	}
+}
@@ -3,504 +3,246 @@ package domain_test
 import (
 	"context"
 	"errors"
+	"slices"
 	"testing"
-	"time"
 
 	"github.com/chains-lab/cities-svc/internal/domain/enum"
 	"github.com/chains-lab/cities-svc/internal/domain/errx"
-	"github.com/chains-lab/cities-svc/internal/domain/models"
 	"github.com/chains-lab/cities-svc/internal/domain/services/city"
-	"github.com/chains-lab/cities-svc/test"
 	"github.com/google/uuid"
 	"github.com/paulmach/orb"
 )
 
-func CreateAndActivateCountry(s Setup, t *testing.T, name string) models.Country {
-	ctx := context.Background()
-
-	ukr, err := s.domain.country.Create(ctx, name)
-	if err != nil {
-		t.Fatalf("CreateCity: %v", err)
-	}
-
-	ukr, err = s.domain.country.UpdateStatus(ctx, ukr.ID, enum.CountryStatusSupported)
-	if err != nil {
-		t.Fatalf("SetCountryStatusSupported: %v", err)
-	}
-	if ukr.Status != enum.CountryStatusSupported {
-		t.Errorf("expected country status 'supported', got '%s'", ukr.Status)
-	}
-
-	return ukr
-}
-
-func CreateCity(s Setup, t *testing.T, countryID uuid.UUID, name string) models.City {
-	ctx := context.Background()
-
-	kyiv, err := s.domain.city.Create(ctx, city.CreateParams{
-		Name:      name,
-		CountryID: countryID,
-		Point:     [2]float64{30.5234, 50.4501}, // Longitude, Latitude
-		Timezone:  "Europe/Kyiv",
-	})
-	if err != nil {
-		t.Fatalf("CreateCity: %v", err)
-	}
-
-	return kyiv
-}
-
-func TestCreateCities(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-
-	kyiv, err := s.domain.city.Create(ctx, city.CreateParams{
-		Name:      "Kyiv",
-		CountryID: ukr.ID,
-		Point:     [2]float64{30.5234, 50.4501},
-		Timezone:  "Europe/Kyiv",
-	})
-	if err != nil {
-		t.Fatalf("CreateCity: %v", err)
-	}
-
-	if kyiv.Name != "Kyiv" {
-		t.Errorf("expected city name 'Kyiv', got '%s'", kyiv.Name)
-	}
-}
-
-func TestCreateCityInUnsupportedCountry(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	usa := CreateAndActivateCountry(s, t, "USA")
-	usa, err = s.domain.country.UpdateStatus(ctx, usa.ID, enum.CountryStatusUnsupported)
-	if err != nil {
-		t.Fatalf("SetCountryStatusUnsupported: %v", err)
-	}
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
-		Name:      "New York",
-		CountryID: usa.ID,
-		Point:     [2]float64{30.5234, 50.4501},
-		Timezone:  "America/New_York",
-	})
-	if !errors.Is(err, errx.ErrorCountryIsNotSupported) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorCountryIsNotSupported, err)
-	}
-}
-
-func TestCreateCityInNonExistentCountry(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
-		Name:      "New York",
-		CountryID: uuid.New(),
-		Point:     [2]float64{30.5234, 50.4501},
-		Timezone:  "America/New_York",
-	})
-	if !errors.Is(err, errx.ErrorCountryNotFound) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorCountryNotFound, err)
-	}
+func ptr[T any](v T) *T {
+	return &v
 }
 
-func TestCreateCityWithInvalidTimezone(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
-		Name:      "New York",
-		CountryID: ukr.ID,
-		Point:     [2]float64{30.5234, 50.4501},
-		Timezone:  "America/Pidor", // Invalid timezone
-	})
-	if !errors.Is(err, errx.ErrorInvalidTimeZone) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidTimeZone, err)
-	}
-}
-
-func TestCreateCityWithInvalidPoint(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
+func TestCreateCity(t *testing.T) {
+	valid := city.CreateParams{
+		CountryID: "UKR",
 		Name:      "Kyiv",
-		CountryID: ukr.ID,
-		Point:     [2]float64{200.0, 50.4501}, // Invalid longitude
 		Timezone:  "Europe/Kyiv",
-	})
-	if !errors.Is(err, errx.ErrorInvalidPoint) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidPoint, err)
-	}
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
-		Name:      "Kyiv",
-		CountryID: ukr.ID,
-		Point:     [2]float64{30.5234, 100.0}, // Invalid latitude
-		Timezone:  "Europe/Kyiv",
-	})
-	if !errors.Is(err, errx.ErrorInvalidPoint) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidPoint, err)
-	}
-}
-
-func TestCreateCityWithInvalidName(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-
-	_, err = s.domain.city.Create(ctx, city.CreateParams{
-		Name:      " ", // Empty name
-		CountryID: ukr.ID,
-		Point:     [2]float64{30.5234, 50.4501},
-		Timezone:  "Europe/Kyiv",
-	})
-	if !errors.Is(err, errx.ErrorInvalidCityName) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidCityName, err)
-	}
-}
-
-func TestGetCities(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cities, err := s.domain.city.GetByID(ctx, kyiv.ID)
-	if err != nil {
-		t.Fatalf("GetByID: %v", err)
-	}
-	if cities.ID != kyiv.ID {
-		t.Fatalf("GetByID: expected city ID %v, got %v", kyiv.ID, cities.ID)
-	}
-}
-
-func TestGetCitiesBySlug(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cities, err := s.domain.city.Update(ctx, kyiv.ID, city.UpdateParams{
-		Slug: func(s string) *string { return &s }("kyiv"),
-	})
-	if err != nil {
-		t.Fatalf("UpdateByCityAdmin: %v", err)
-	}
-
-	cityBySlug, err := s.domain.city.GetBySlug(ctx, "kyiv")
-	if err != nil {
-		t.Fatalf("GetBySlug: %v", err)
-	}
-	if cityBySlug.ID != cities.ID {
-		t.Fatalf("GetBySlug: expected city ID %v, got %v", cities.ID, cityBySlug.ID)
-	}
-}
-
-func TestListCities(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	_ = CreateCity(s, t, ukr.ID, "Kyiv")
-	_ = CreateCity(s, t, ukr.ID, "Lviv")
-
-	usa := CreateAndActivateCountry(s, t, "USA")
-	_ = CreateCity(s, t, usa.ID, "New York")
-
-	cities, err := s.domain.city.Filter(ctx, city.FilterParams{}, 1, 10)
-	if err != nil {
-		t.Fatalf("ListCities: %v", err)
-	}
-	for _, c := range cities.Data {
-		t.Logf("City: %s, CountryID: %s", c.Name, c.CountryID)
-	}
-}
-
-func TestUpdateCities(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cityUpdZone := "Europe/Kyiv"
-	cityUpdName := "Kyiv Updated"
-	point := orb.Point{30.5234, 50.4501}
-	kyiv, err = s.domain.city.Update(ctx, kyiv.ID, city.UpdateParams{
-		Name:     &cityUpdName,
-		Timezone: &cityUpdZone,
-		Point:    &point,
-	})
-	if err != nil {
-		t.Fatalf("UpdateByCityAdmin: %v", err)
-	}
-}
-
-func TestUpdateCityFailsForNonExistentCity(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	cityUpdZone := "Europe/Kyiv"
-	cityUpdName := "Kyiv Updated"
-	point := orb.Point{30.5234, 50.4501}
-	_, err = s.domain.city.Update(ctx, uuid.New(), city.UpdateParams{
-		Name:     &cityUpdName,
-		Timezone: &cityUpdZone,
-		Point:    &point,
-	})
-	if !errors.Is(err, errx.ErrorCityNotFound) {
-		t.Fatalf("UpdateByCityAdmin: %v", err)
-	}
-}
-
-func TestUpdateCityFailsForInvalidTimezone(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
-
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cityUpdZone := "Europe/Invalid"
-	cityUpdName := "Kyiv Updated"
-	point := orb.Point{30.5234, 50.4501}
-	kyiv, err = s.domain.city.Update(ctx, kyiv.ID, city.UpdateParams{
-		Name:     &cityUpdName,
-		Timezone: &cityUpdZone, // Invalid timezone
-		Point:    &point,
-	})
-	if !errors.Is(err, errx.ErrorInvalidTimeZone) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidTimeZone, err)
+		Status:    enum.CityStatusSupported,
+		Point:     orb.Point{30.5234, 50.4501},
+	}
+
+	cases := []struct {
+		name   string
+		modify func(p *city.CreateParams)
+		want   error
+	}{
+		{name: "valid", modify: func(p *city.CreateParams) {}},
+		{name: "invalid timezone", modify: func(p *city.CreateParams) { p.Timezone = "Mars/Olympus" }, want: errx.ErrorInvalidTimeZone},
+		{name: "longitude out of range", modify: func(p *city.CreateParams) { p.Point = orb.Point{181, 0} }, want: errx.ErrorInvalidPoint},
+		{name: "latitude out of range", modify: func(p *city.CreateParams) { p.Point = orb.Point{0, -91} }, want: errx.ErrorInvalidPoint},
+		{name: "invalid name", modify: func(p *city.CreateParams) { p.Name = "Kyiv_1" }, want: errx.ErrorInvalidCityName},
+		{name: "invalid status", modify: func(p *city.CreateParams) { p.Status = "closed" }, want: errx.ErrorInvalidCityStatus},
+		{name: "unknown country", modify: func(p *city.CreateParams) { p.CountryID = "XXX" }, want: errx.ErrorInvalidCountryISO3ID},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			params := valid
+			tc.modify(&params)
+
+			c, err := s.city.Create(context.Background(), params)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			got, err := s.city.GetByID(context.Background(), c.ID)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			if got.Name != params.Name || got.Status != params.Status {
+				t.Errorf("unexpected city %+v", got)
+			}
+		})
+	}
+}
+
+func TestUpdateCity(t *testing.T) {
+	cases := []struct {
+		name   string
+		role   string
+		params city.UpdateParams
+		want   error
+	}{
+		{name: "tech lead renames", role: enum.CityAdminRoleTechLead, params: city.UpdateParams{Name: ptr("Kyiv City")}},
+		{name: "moderator sets slug", role: enum.CityAdminRoleModerator, params: city.UpdateParams{Slug: ptr("kyiv")}},
+		{name: "member cannot update", role: enum.CityAdminRoleMember, params: city.UpdateParams{Name: ptr("Kiev")}, want: errx.ErrorNotEnoughRight},
+		{name: "invalid slug", role: enum.CityAdminRoleTechLead, params: city.UpdateParams{Slug: ptr("Kyiv 1")}, want: errx.ErrorInvalidSlug},
+		{name: "taken slug", role: enum.CityAdminRoleTechLead, params: city.UpdateParams{Slug: ptr("lviv")}, want: errx.ErrorCityAlreadyExistsWithThisSlug},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			lviv := s.createCity(t, "Lviv")
+			if _, err := s.city.UpdateByAdmin(ctx, lviv.ID, city.UpdateParams{Slug: ptr("lviv")}); err != nil {
+				t.Fatalf("set lviv slug: %v", err)
+			}
+
+			kyiv := s.createCity(t, "Kyiv")
+			initiator := s.createAdmin(t, kyiv.ID, tc.role)
+
+			_, err := s.city.UpdateByCityAdmin(ctx, initiator.UserID, kyiv.ID, tc.params)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("update: %v", err)
+			}
+
+			got, err := s.city.GetByID(ctx, kyiv.ID)
+			if err != nil {
+				t.Fatalf("get: %v", err)
+			}
+			if tc.params.Name != nil && got.Name != *tc.params.Name {
+				t.Errorf("name = %q, want %q", got.Name, *tc.params.Name)
+			}
+			if tc.params.Slug != nil && (got.Slug == nil || *got.Slug != *tc.params.Slug) {
+				t.Errorf("slug = %v, want %q", got.Slug, *tc.params.Slug)
+			}
+		})
+	}
+}
+
+func TestUpdateCityStatus(t *testing.T) {
+	cases := []struct {
+		name       string
+		status     string
+		wantAdmins int
+		want       error
+	}{
+		{name: "stays supported", status: enum.CityStatusSupported, wantAdmins: 2},
+		{name: "suspend removes admins", status: enum.CityStatusSuspended, wantAdmins: 0},
+		{name: "unsupport removes admins", status: enum.CityStatusUnsupported, wantAdmins: 0},
+		{name: "invalid status", status: "closed", wantAdmins: 2, want: errx.ErrorInvalidCityStatus},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			kyiv := s.createCity(t, "Kyiv")
+			s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+			s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+
+			_, err := s.city.UpdateStatusBySysAdmin(ctx, kyiv.ID, tc.status)
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+
+			admins, err := s.db.GetCityAdmins(ctx, kyiv.ID)
+			if err != nil {
+				t.Fatalf("get admins: %v", err)
+			}
+			if len(admins.Data) != tc.wantAdmins {
+				t.Errorf("admins = %d, want %d", len(admins.Data), tc.wantAdmins)
+			}
+		})
 	}
 }
 
-func TestUpdateCityFailsForInvalidPoint(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
+func TestUpdateCityStatusByCityAdmin(t *testing.T) {
+	for _, role := range enum.GetAllCityAdminRoles() {
+		t.Run(role, func(t *testing.T) {
+			s := newSetup(t)
+			kyiv := s.createCity(t, "Kyiv")
+			initiator := s.createAdmin(t, kyiv.ID, role)
 
-	ctx := context.Background()
+			var want error
+			if !slices.Contains(enum.GetCityAdminPermissions(role), enum.CityAdminPermissionUpdateCityStatus) {
+				want = errx.ErrorNotEnoughRight
+			}
 
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cityUpdZone := "Europe/Kyiv"
-	cityUpdName := "Kyiv Updated"
-	point := orb.Point{301.5234, 501.4501}
-	_, err = s.domain.city.Update(ctx, kyiv.ID, city.UpdateParams{
-		Name:     &cityUpdName,
-		Timezone: &cityUpdZone,
-		Point:    &point,
-	})
-	if !errors.Is(err, errx.ErrorInvalidPoint) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidPoint, err)
+			_, err := s.city.UpdateStatusByCityAdmin(context.Background(), initiator.UserID, kyiv.ID, enum.CityStatusSupported)
+			if !errors.Is(err, want) {
+				t.Errorf("expected %v, got %v", want, err)
+			}
+		})
 	}
-}
 
-func TestUpdateCityFailsForInvalidName(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
-	ctx := context.Background()
+	s := newSetup(t)
+	kyiv := s.createCity(t, "Kyiv")
 
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	cityUpdZone := "Europe/Kyiv"
-	cityUpdName := "Kyiv 12121 Updated"
-	point := orb.Point{01.5234, 01.4501}
-	_, err = s.domain.city.Update(ctx, kyiv.ID, city.UpdateParams{
-		Name:     &cityUpdName,
-		Timezone: &cityUpdZone,
-		Point:    &point,
-	})
-	if !errors.Is(err, errx.ErrorInvalidCityName) {
-		t.Fatalf("expected error %v, got %v", errx.ErrorInvalidCityName, err)
+	_, err := s.city.UpdateStatusByCityAdmin(context.Background(), uuid.New(), kyiv.ID, enum.CityStatusSupported)
+	if !errors.Is(err, errx.ErrorNotEnoughRight) {
+		t.Errorf("not an admin: expected %v, got %v", errx.ErrorNotEnoughRight, err)
 	}
 }
 
-func TestSetCityStatus(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
+func TestFilterCities(t *testing.T) {
+	s := newSetup(t)
 	ctx := context.Background()
 
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-
-	kyiv, err = s.domain.city.UpdateStatus(ctx, kyiv.ID, enum.CityStatusOfficial)
-	if err != nil {
-		t.Fatalf("SetCityStatusOfficial: %v", err)
-	}
-	if kyiv.Status != enum.CityStatusOfficial {
-		t.Errorf("expected city status 'official', got '%s'", kyiv.Status)
+	kyiv := s.createCity(t, "Kyiv")
+	for _, name := range []string{"Kharkiv", "Lviv"} {
+		s.createCity(t, name)
 	}
 
-	kyiv, err = s.domain.city.UpdateStatus(ctx, kyiv.ID, enum.CityStatusUnsupported)
-	if err != nil {
-		t.Fatalf("SetCityStatusUnsupported: %v", err)
-	}
-	if kyiv.Status != enum.CityStatusUnsupported {
-		t.Errorf("expected city status 'Unsupported', got '%s'", kyiv.Status)
+	cases := []struct {
+		name   string
+		filter city.FilterParams
+		want   int
+	}{
+		{name: "all", filter: city.FilterParams{}, want: 3},
+		{name: "name is case insensitive", filter: city.FilterParams{Name: ptr("KIV")}, want: 1},
+		{name: "status", filter: city.FilterParams{Status: ptr(enum.CityStatusSuspended)}, want: 0},
+		{name: "country", filter: city.FilterParams{CountryID: ptr("UKR")}, want: 3},
+		{name: "ids", filter: city.FilterParams{ID: []uuid.UUID{kyiv.ID, uuid.New()}}, want: 1},
 	}
 
-	_, err = s.domain.invites.Create(context.Background(), enum.CityGovRoleExecutive, kyiv.ID, time.Hour)
-	if !errors.Is(err, errx.ErrorCityIsNotSupported) {
-		t.Fatalf("expected error when creating mayor invite for Unsupported city, got: %v", err)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.city.Filter(ctx, tc.filter, 1, 10)
+			if err != nil {
+				t.Fatalf("filter: %v", err)
+			}
+			if res.Total != uint64(tc.want) || len(res.Data) != tc.want {
+				t.Errorf("got %d cities (total %d), want %d", len(res.Data), res.Total, tc.want)
+			}
+		})
 	}
 }
 
-func TestSetCityStatusInUnsupportedCountry(t *testing.T) {
-	s, err := newSetup(t)
-	test.CleanDb(t)
-	if err != nil {
-		t.Fatalf("newSetup: %v", err)
-	}
-
+func TestGetCitiesByIDs(t *testing.T) {
+	s := newSetup(t)
 	ctx := context.Background()
 
-	ukr := CreateAndActivateCountry(s, t, "Ukraine")
-	kyiv := CreateCity(s, t, ukr.ID, "Kyiv")
-	lviv := CreateCity(s, t, ukr.ID, "Lviv")
+	kyiv := s.createCity(t, "Kyiv")
+	lviv := s.createCity(t, "Lviv")
+	s.createCity(t, "Odesa")
+	missing := uuid.New()
 
-	ukr, err = s.domain.country.UpdateStatus(ctx, ukr.ID, enum.CountryStatusUnsupported)
+	cities, notFound, err := s.city.GetByIDs(ctx, []uuid.UUID{lviv.ID, missing, kyiv.ID, lviv.ID, missing})
 	if err != nil {
-		t.Fatalf("SetCountryStatusUnsupported: %v", err)
-	}
-	if ukr.Status != enum.CountryStatusUnsupported {
-		t.Errorf("expected country status 'Unsupported', got '%s'", ukr.Status)
+		t.Fatalf("get by ids: %v", err)
 	}
 
-	kyiv, err = s.domain.city.GetByID(ctx, kyiv.ID)
-	if err != nil {
-		t.Fatalf("GetByID: %v", err)
-	}
-	if kyiv.Status != enum.CityStatusUnsupported {
-		t.Errorf("expected city status 'Unsupported' after country Unsupported, got '%s'", kyiv.Status)
+	if len(cities) != 2 || cities[0].ID != lviv.ID || cities[1].ID != kyiv.ID {
+		t.Errorf("cities = %v, want Lviv and Kyiv once each in request order", cities)
 	}
-	lviv, err = s.domain.city.GetByID(ctx, lviv.ID)
-	if err != nil {
-		t.Fatalf("GetByID: %v", err)
-	}
-	if lviv.Status != enum.CityStatusUnsupported {
-		t.Errorf("expected city status 'Unsupported' after country Unsupported, got '%s'", lviv.Status)
-	}
-
-	_, err = s.domain.city.UpdateStatus(ctx, kyiv.ID, enum.CityStatusOfficial)
-	if !errors.Is(err, errx.ErrorCountryIsNotSupported) {
-		t.Fatalf("expected error when setting city status in Unsupported country, got: %v", err)
+	if len(notFound) != 1 || notFound[0] != missing {
+		t.Errorf("not found = %v, want [%s]", notFound, missing)
 	}
 
-	ukr, err = s.domain.country.UpdateStatus(ctx, ukr.ID, enum.CountryStatusSupported)
-	if err != nil {
-		t.Fatalf("UpdateByCityAdmin country status to active: %v", err)
-	}
-	if ukr.Status != enum.CountryStatusSupported {
-		t.Errorf("expected country status 'supported', got '%s'", ukr.Status)
-	}
-
-	kyiv, err = s.domain.city.GetByID(ctx, kyiv.ID)
-	if err != nil {
-		t.Fatalf("GetByID: %v", err)
-	}
-	if kyiv.Status != enum.CityStatusUnsupported {
-		t.Errorf("expected city status 'Unsupported' after country supported, got '%s'", kyiv.Status)
-	}
-	lviv, err = s.domain.city.GetByID(ctx, lviv.ID)
-	if err != nil {
-		t.Fatalf("GetByID: %v", err)
-	}
-	if lviv.Status != enum.CityStatusUnsupported {
-		t.Errorf("expected city status 'Unsupported' after country supported, got '%s'", lviv.Status)
-	}
-
-	kyiv, err = s.domain.city.UpdateStatus(ctx, kyiv.ID, enum.CityStatusOfficial)
-	if err != nil {
-		t.Fatalf("SetCityStatusOfficial: %v", err)
-	}
-	if kyiv.Status != enum.CityStatusOfficial {
-		t.Errorf("expected city status 'official', got '%s'", kyiv.Status)
+	cities, notFound, err = s.city.GetByIDs(ctx, nil)
+	if err != nil || len(cities) != 0 || len(notFound) != 0 {
+		t.Errorf("no ids: got %v, %v, %v", cities, notFound, err)
 	}
 }
@@ -0,0 +1,145 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/services/idempotency"
+	"github.com/google/uuid"
+)
+
+func TestBeginIdempotentRequest(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	key, err := s.idempotency.Begin(ctx, userID, "k1", "fp1")
+	if err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if key.Completed() || !key.ExpiresAt.After(time.Now()) {
+		t.Errorf("new key = %+v", key)
+	}
+
+	if _, err = s.idempotency.Begin(ctx, userID, "k1", "fp1"); !errors.Is(err, errx.ErrorIdempotencyKeyInProgress) {
+		t.Errorf("retry in progress: err = %v, want %v", err, errx.ErrorIdempotencyKeyInProgress)
+	}
+	if _, err = s.idempotency.Begin(ctx, userID, "k1", "fp2"); !errors.Is(err, errx.ErrorIdempotencyKeyReused) {
+		t.Errorf("other request: err = %v, want %v", err, errx.ErrorIdempotencyKeyReused)
+	}
+	if _, err = s.idempotency.Begin(ctx, uuid.New(), "k1", "fp2"); err != nil {
+		t.Errorf("key of another user: %v", err)
+	}
+
+	if err = s.idempotency.Complete(ctx, userID, "k1", 201, "application/json", []byte(`{"data":{}}`)); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+
+	key, err = s.idempotency.Begin(ctx, userID, "k1", "fp1")
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	if !key.Completed() || key.StatusCode != 201 || key.ContentType != "application/json" || string(key.Body) != `{"data":{}}` {
+		t.Errorf("replayed key = %+v", key)
+	}
+	if _, err = s.idempotency.Begin(ctx, userID, "k1", "fp2"); !errors.Is(err, errx.ErrorIdempotencyKeyReused) {
+		t.Errorf("other request after completion: err = %v, want %v", err, errx.ErrorIdempotencyKeyReused)
+	}
+}
+
+func TestBeginInvalidIdempotencyKey(t *testing.T) {
+	s := newSetup(t)
+
+	for _, key := range []string{"", strings.Repeat("k", idempotency.MaxKeyLength+1)} {
+		_, err := s.idempotency.Begin(context.Background(), uuid.New(), key, "fp")
+		if !errors.Is(err, errx.ErrorInvalidIdempotencyKey) {
+			t.Errorf("key of %d chars: err = %v, want %v", len(key), err, errx.ErrorInvalidIdempotencyKey)
+		}
+	}
+}
+
+func TestReleaseIdempotencyKey(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	if _, err := s.idempotency.Begin(ctx, userID, "k1", "fp1"); err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if err := s.idempotency.Release(ctx, userID, "k1"); err != nil {
+		t.Fatalf("release: %v", err)
+	}
+
+	key, err := s.idempotency.Begin(ctx, userID, "k1", "fp1")
+	if err != nil || key.Completed() {
+		t.Errorf("begin after release = %+v, %v", key, err)
+	}
+}
+
+func TestTakeOverStaleIdempotencyKey(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// requests of this service outlive their lease as soon as they begin,
+	// like those of an instance which went down
+	crashed := idempotency.NewService(s.db, time.Hour, -time.Second)
+
+	if _, err := crashed.Begin(ctx, userID, "k1", "fp1"); err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if _, err := s.idempotency.Begin(ctx, userID, "k1", "fp2"); !errors.Is(err, errx.ErrorIdempotencyKeyReused) {
+		t.Errorf("other request: err = %v, want %v", err, errx.ErrorIdempotencyKeyReused)
+	}
+
+	key, err := s.idempotency.Begin(ctx, userID, "k1", "fp1")
+	if err != nil {
+		t.Fatalf("take over: %v", err)
+	}
+	if key.Completed() || !key.LockedUntil.After(time.Now()) {
+		t.Errorf("taken over key = %+v", key)
+	}
+
+	if _, err = s.idempotency.Begin(ctx, userID, "k1", "fp1"); !errors.Is(err, errx.ErrorIdempotencyKeyInProgress) {
+		t.Errorf("retry after take over: err = %v, want %v", err, errx.ErrorIdempotencyKeyInProgress)
+	}
+}
+
+func TestExpiredIdempotencyKeys(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+	userID := uuid.New()
+
+	// keys of this service are expired as soon as they are created
+	expired := idempotency.NewService(s.db, -time.Second, time.Minute)
+
+	if _, err := expired.Begin(ctx, userID, "k1", "fp1"); err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+	if err := expired.Complete(ctx, userID, "k1", 201, "application/json", nil); err != nil {
+		t.Fatalf("complete: %v", err)
+	}
+	if _, err := expired.Begin(ctx, userID, "k2", "fp2"); err != nil {
+		t.Fatalf("begin: %v", err)
+	}
+
+	key, err := s.idempotency.Begin(ctx, userID, "k1", "fp2")
+	if err != nil || key.Completed() || key.Fingerprint != "fp2" {
+		t.Errorf("begin with expired key = %+v, %v", key, err)
+	}
+
+	if err = s.idempotency.Purge(ctx); err != nil {
+		t.Fatalf("purge: %v", err)
+	}
+
+	if key, _ = s.db.GetIdempotencyKey(ctx, userID, "k2"); !key.IsNil() {
+		t.Errorf("expired key k2 not purged: %+v", key)
+	}
+	if key, _ = s.db.GetIdempotencyKey(ctx, userID, "k1"); key.IsNil() {
+		t.Error("live key k1 purged")
+	}
+}
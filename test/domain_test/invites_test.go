@@ -0,0 +1,309 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/google/uuid"
+)
+
+func TestCreateInvite(t *testing.T) {
+	cases := []struct {
+		name      string
+		initiator string
+		role      string
+		missing   bool
+		want      error
+	}{
+		{name: "tech lead invites moderator", initiator: enum.CityAdminRoleTechLead, role: enum.CityAdminRoleModerator},
+		{name: "moderator invites member", initiator: enum.CityAdminRoleModerator, role: enum.CityAdminRoleMember},
+		{name: "moderator cannot invite chief", initiator: enum.CityAdminRoleModerator, role: enum.CityAdminRoleChief, want: errx.ErrorNotEnoughRight},
+		{name: "member cannot invite", initiator: enum.CityAdminRoleMember, role: enum.CityAdminRoleMember, want: errx.ErrorNotEnoughRight},
+		{name: "invalid role", initiator: enum.CityAdminRoleTechLead, role: "mayor", want: errx.ErrorInvalidCityAdminRole},
+		{name: "unknown user", initiator: enum.CityAdminRoleTechLead, role: enum.CityAdminRoleMember, missing: true, want: errx.ErrorInviteeNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			kyiv := s.createCity(t, "Kyiv")
+			initiator := s.createAdmin(t, kyiv.ID, tc.initiator)
+
+			userID := uuid.New()
+			if tc.missing {
+				s.profiles.missing[userID] = true
+			}
+
+			inv, err := s.invite.CreateByCityAdmin(ctx, initiator.UserID, invite.CreateParams{
+				UserID:   userID,
+				CityID:   kyiv.ID,
+				Role:     tc.role,
+				Duration: time.Hour,
+			})
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			if inv.Status != enum.InviteStatusSent || inv.InitiatorID != initiator.UserID {
+				t.Errorf("unexpected invite %+v", inv)
+			}
+			if !slices.Contains(s.events.published(), "invite.created") {
+				t.Errorf("invite.created was not published, got %v", s.events.published())
+			}
+		})
+	}
+}
+
+func TestCreateInviteForExistingAdmin(t *testing.T) {
+	s := newSetup(t)
+
+	kyiv := s.createCity(t, "Kyiv")
+	member := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleMember)
+
+	_, err := s.invite.CreateBySysAdmin(context.Background(), uuid.New(), invite.CreateParams{
+		UserID:   member.UserID,
+		CityID:   kyiv.ID,
+		Role:     enum.CityAdminRoleModerator,
+		Duration: time.Hour,
+	})
+	if !errors.Is(err, errx.ErrorCityAdminAlreadyExists) {
+		t.Fatalf("expected %v, got %v", errx.ErrorCityAdminAlreadyExists, err)
+	}
+}
+
+func TestReplyInvite(t *testing.T) {
+	cases := []struct {
+		name       string
+		reply      string
+		ttl        time.Duration
+		otherUser  bool
+		replyTwice bool
+		wantStatus string
+		wantAdmin  bool
+		want       error
+	}{
+		{name: "accept", reply: enum.InviteStatusAccepted, ttl: time.Hour, wantStatus: enum.InviteStatusAccepted, wantAdmin: true},
+		{name: "decline", reply: enum.InviteStatusDeclined, ttl: time.Hour, wantStatus: enum.InviteStatusDeclined},
+		{name: "invalid answer", reply: enum.InviteStatusSent, ttl: time.Hour, wantStatus: enum.InviteStatusSent, want: errx.ErrorInvalidInviteReply},
+		{name: "expired", reply: enum.InviteStatusAccepted, ttl: -time.Minute, wantStatus: enum.InviteStatusSent, want: errx.ErrorInviteExpired},
+		{name: "addressed to another user", reply: enum.InviteStatusAccepted, ttl: time.Hour, otherUser: true, wantStatus: enum.InviteStatusSent, want: errx.ErrorNotEnoughRight},
+		{name: "already answered", reply: enum.InviteStatusDeclined, ttl: time.Hour, replyTwice: true, wantStatus: enum.InviteStatusDeclined, want: errx.ErrorInviteAlreadyReplied},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+			ctx := context.Background()
+
+			kyiv := s.createCity(t, "Kyiv")
+			inv := s.createInvite(t, kyiv.ID, enum.CityAdminRoleModerator, tc.ttl)
+
+			userID := inv.UserID
+			if tc.otherUser {
+				userID = uuid.New()
+			}
+
+			var err error
+			if tc.replyTwice {
+				if _, err = s.invite.Reply(ctx, userID, inv.ID, tc.reply); err != nil {
+					t.Fatalf("first reply: %v", err)
+				}
+			}
+
+			_, err = s.invite.Reply(ctx, userID, inv.ID, tc.reply)
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("expected %v, got %v", tc.want, err)
+			}
+
+			got, err := s.invite.Get(ctx, inv.ID)
+			if err != nil {
+				t.Fatalf("get invite: %v", err)
+			}
+			if got.Status != tc.wantStatus {
+				t.Errorf("status = %s, want %s", got.Status, tc.wantStatus)
+			}
+
+			a, err := s.db.GetCityAdmin(ctx, inv.UserID, kyiv.ID)
+			if err != nil {
+				t.Fatalf("get admin: %v", err)
+			}
+			if a.IsNil() == tc.wantAdmin {
+				t.Errorf("admin exists = %t, want %t", !a.IsNil(), tc.wantAdmin)
+			}
+		})
+	}
+}
+
+func TestAcceptTechLeadInviteReplacesTechLead(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	lead := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleTechLead)
+	inv := s.createInvite(t, kyiv.ID, enum.CityAdminRoleTechLead, time.Hour)
+
+	if _, err := s.invite.Reply(ctx, inv.UserID, inv.ID, enum.InviteStatusAccepted); err != nil {
+		t.Fatalf("accept: %v", err)
+	}
+
+	got, err := s.db.GetCityTechLead(ctx, kyiv.ID)
+	if err != nil {
+		t.Fatalf("get tech lead: %v", err)
+	}
+	if got.UserID != inv.UserID {
+		t.Errorf("tech lead = %s, want %s", got.UserID, inv.UserID)
+	}
+
+	old, err := s.db.GetCityAdmin(ctx, lead.UserID, kyiv.ID)
+	if err != nil {
+		t.Fatalf("get previous tech lead: %v", err)
+	}
+	if !old.IsNil() {
+		t.Errorf("previous tech lead is still admin: %+v", old)
+	}
+}
+
+func TestRevokeInvite(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	inv := s.createInvite(t, kyiv.ID, enum.CityAdminRoleMember, time.Hour)
+
+	res, err := s.invite.Revoke(ctx, inv.ID)
+	if err != nil {
+		t.Fatalf("revoke: %v", err)
+	}
+	if res.Status != enum.InviteStatusRevoked {
+		t.Errorf("status = %s, want %s", res.Status, enum.InviteStatusRevoked)
+	}
+
+	if _, err = s.invite.Reply(ctx, inv.UserID, inv.ID, enum.InviteStatusAccepted); !errors.Is(err, errx.ErrorInviteAlreadyReplied) {
+		t.Errorf("expected %v after revoke, got %v", errx.ErrorInviteAlreadyReplied, err)
+	}
+	if _, err = s.invite.Revoke(ctx, inv.ID); !errors.Is(err, errx.ErrorInviteAlreadyReplied) {
+		t.Errorf("expected %v on second revoke, got %v", errx.ErrorInviteAlreadyReplied, err)
+	}
+}
+
+func TestFilterInvitesByCityAdmin(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	kyiv := s.createCity(t, "Kyiv")
+	moder := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleModerator)
+	member := s.createAdmin(t, kyiv.ID, enum.CityAdminRoleMember)
+
+	declined := s.createInvite(t, kyiv.ID, enum.CityAdminRoleMember, time.Hour)
+	s.createInvite(t, kyiv.ID, enum.CityAdminRoleMember, time.Hour)
+	if _, err := s.invite.Reply(ctx, declined.UserID, declined.ID, enum.InviteStatusDeclined); err != nil {
+		t.Fatalf("decline: %v", err)
+	}
+
+	cases := []struct {
+		name      string
+		initiator uuid.UUID
+		status    []string
+		wantTotal uint64
+		want      error
+	}{
+		{name: "all", initiator: moder.UserID, wantTotal: 2},
+		{name: "by status", initiator: moder.UserID, status: []string{enum.InviteStatusSent}, wantTotal: 1},
+		{name: "invalid status", initiator: moder.UserID, status: []string{"lost"}, want: errx.ErrorInvalidInviteStatus},
+		{name: "member cannot list", initiator: member.UserID, want: errx.ErrorNotEnoughRight},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := s.invite.FilterByCityAdmin(ctx, tc.initiator, kyiv.ID, invite.FilterParams{Status: tc.status}, 1, 10)
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("filter: %v", err)
+			}
+			if res.Total != tc.wantTotal {
+				t.Errorf("total = %d, want %d", res.Total, tc.wantTotal)
+			}
+		})
+	}
+}
+
+// racingDB answers an invite on behalf of another request right after the
+// service has read it.
+type racingDB struct {
+	*memory.DB
+	status string
+	raced  bool
+}
+
+func (db *racingDB) GetInvite(ctx context.Context, id uuid.UUID) (models.Invite, error) {
+	inv, err := db.DB.GetInvite(ctx, id)
+	if err != nil || db.raced {
+		return inv, err
+	}
+
+	db.raced = true
+	return inv, db.DB.UpdateInviteStatus(ctx, id, db.status)
+}
+
+func TestInviteAnsweredConcurrently(t *testing.T) {
+	t.Run("accept after revoke", func(t *testing.T) {
+		s := newSetup(t)
+		ctx := context.Background()
+
+		kyiv := s.createCity(t, "Kyiv")
+		inv := s.createInvite(t, kyiv.ID, enum.CityAdminRoleModerator, time.Hour)
+
+		svc := invite.NewService(&racingDB{DB: s.db, status: enum.InviteStatusRevoked}, s.events, s.profiles)
+		if _, err := svc.Reply(ctx, inv.UserID, inv.ID, enum.InviteStatusAccepted); !errors.Is(err, errx.ErrorInviteAlreadyReplied) {
+			t.Fatalf("expected %v, got %v", errx.ErrorInviteAlreadyReplied, err)
+		}
+
+		got, err := s.invite.Get(ctx, inv.ID)
+		if err != nil {
+			t.Fatalf("get invite: %v", err)
+		}
+		if got.Status != enum.InviteStatusRevoked {
+			t.Errorf("status = %s, want %s", got.Status, enum.InviteStatusRevoked)
+		}
+
+		a, err := s.db.GetCityAdmin(ctx, inv.UserID, kyiv.ID)
+		if err != nil {
+			t.Fatalf("get admin: %v", err)
+		}
+		if !a.IsNil() {
+			t.Errorf("admin created for a revoked invite: %+v", a)
+		}
+	})
+
+	t.Run("revoke after accept", func(t *testing.T) {
+		s := newSetup(t)
+		kyiv := s.createCity(t, "Kyiv")
+		inv := s.createInvite(t, kyiv.ID, enum.CityAdminRoleModerator, time.Hour)
+
+		svc := invite.NewService(&racingDB{DB: s.db, status: enum.InviteStatusAccepted}, s.events, s.profiles)
+		if _, err := svc.Revoke(context.Background(), inv.ID); !errors.Is(err, errx.ErrorInviteAlreadyReplied) {
+			t.Fatalf("expected %v, got %v", errx.ErrorInviteAlreadyReplied, err)
+		}
+	})
+}
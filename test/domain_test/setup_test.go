@@ -0,0 +1,167 @@
+package domain_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/admin"
+	"github.com/chains-lab/cities-svc/internal/domain/services/city"
+	"github.com/chains-lab/cities-svc/internal/domain/services/idempotency"
+	"github.com/chains-lab/cities-svc/internal/domain/services/invite"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/chains-lab/cities-svc/internal/repo/memory"
+	"github.com/google/uuid"
+	"github.com/paulmach/orb"
+)
+
+// webhookRetry gives up on a delivery after three attempts, retried after one
+// and two minutes.
+var webhookRetry = webhook.Retry{
+	MaxAttempts: 3,
+	Backoff:     time.Minute,
+	MaxBackoff:  2 * time.Minute,
+}
+
+type Setup struct {
+	db       *memory.DB
+	events   *events
+	profiles profiles
+
+	city        city.Service
+	admin       admin.Service
+	invite      invite.Service
+	webhook     webhook.Service
+	idempotency idempotency.Service
+}
+
+func newSetup(t *testing.T) Setup {
+	t.Helper()
+
+	db := memory.New()
+	ev := &events{}
+	pr := profiles{missing: map[uuid.UUID]bool{}}
+
+	return Setup{
+		db:          db,
+		events:      ev,
+		profiles:    pr,
+		city:        city.NewService(db, ev),
+		admin:       admin.NewService(db, ev),
+		invite:      invite.NewService(db, ev, pr),
+		webhook:     webhook.NewService(db, webhookRetry),
+		idempotency: idempotency.NewService(db, time.Hour, time.Minute),
+	}
+}
+
+func (s Setup) createCity(t *testing.T, name string) models.City {
+	t.Helper()
+
+	c, err := s.city.Create(context.Background(), city.CreateParams{
+		CountryID: "UKR",
+		Name:      name,
+		Timezone:  "Europe/Kyiv",
+		Status:    enum.CityStatusSupported,
+		Point:     orb.Point{30.5234, 50.4501},
+	})
+	if err != nil {
+		t.Fatalf("create city %s: %v", name, err)
+	}
+
+	return c
+}
+
+func (s Setup) createAdmin(t *testing.T, cityID uuid.UUID, role string) models.CityAdmin {
+	t.Helper()
+
+	a, err := s.admin.Create(context.Background(), uuid.New(), cityID, role)
+	if err != nil {
+		t.Fatalf("create %s: %v", role, err)
+	}
+
+	return a
+}
+
+func (s Setup) createInvite(t *testing.T, cityID uuid.UUID, role string, ttl time.Duration) models.Invite {
+	t.Helper()
+
+	inv, err := s.invite.CreateBySysAdmin(context.Background(), uuid.New(), invite.CreateParams{
+		UserID:   uuid.New(),
+		CityID:   cityID,
+		Role:     role,
+		Duration: ttl,
+	})
+	if err != nil {
+		t.Fatalf("create invite: %v", err)
+	}
+
+	return inv
+}
+
+type profiles struct {
+	missing map[uuid.UUID]bool
+}
+
+func (p profiles) UserExists(_ context.Context, userID uuid.UUID) (bool, error) {
+	return !p.missing[userID], nil
+}
+
+// events records the names of published events in order.
+type events struct {
+	mu    sync.Mutex
+	names []string
+}
+
+func (e *events) record(name string) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.names = append(e.names, name)
+	return nil
+}
+
+func (e *events) published() []string {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return append([]string(nil), e.names...)
+}
+
+func (e *events) PublishCityCreated(context.Context, models.City) error {
+	return e.record("city.created")
+}
+
+func (e *events) PublishCityUpdated(context.Context, models.City, ...uuid.UUID) error {
+	return e.record("city.updated")
+}
+
+func (e *events) PublishCityUpdatedStatus(context.Context, models.City, string, ...uuid.UUID) error {
+	return e.record("city.updated_status")
+}
+
+func (e *events) PublishCityAdminCreated(context.Context, models.CityAdmin, models.City, ...uuid.UUID) error {
+	return e.record("city_admin.created")
+}
+
+func (e *events) PublishCityAdminUpdated(context.Context, models.CityAdmin, models.City, ...uuid.UUID) error {
+	return e.record("city_admin.updated")
+}
+
+func (e *events) PublishCityAdminDeleted(context.Context, models.CityAdmin, models.City, ...uuid.UUID) error {
+	return e.record("city_admin.deleted")
+}
+
+func (e *events) PublishInviteCreated(context.Context, models.Invite, models.City, ...uuid.UUID) error {
+	return e.record("invite.created")
+}
+
+func (e *events) PublishInviteAccepted(context.Context, models.Invite, models.City, models.CityAdmin, ...uuid.UUID) error {
+	return e.record("invite.accepted")
+}
+
+func (e *events) PublishInviteDeclined(context.Context, models.Invite, models.City, ...uuid.UUID) error {
+	return e.record("invite.declined")
+}
@@ -0,0 +1,250 @@
+package domain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/internal/domain/enum"
+	"github.com/chains-lab/cities-svc/internal/domain/errx"
+	"github.com/chains-lab/cities-svc/internal/domain/models"
+	"github.com/chains-lab/cities-svc/internal/domain/services/webhook"
+	"github.com/google/uuid"
+)
+
+func (s Setup) createWebhook(t *testing.T, eventTypes ...string) models.Webhook {
+	t.Helper()
+
+	w, err := s.webhook.Create(context.Background(), webhook.CreateParams{
+		URL:        "https://partner.example/hooks",
+		EventTypes: eventTypes,
+	})
+	if err != nil {
+		t.Fatalf("create webhook: %v", err)
+	}
+
+	return w
+}
+
+// deliveries returns every delivery of the webhook, newest first.
+func (s Setup) deliveries(t *testing.T, webhookID uuid.UUID) []models.WebhookDelivery {
+	t.Helper()
+
+	res, err := s.webhook.FilterDeliveries(context.Background(), webhook.FilterDeliveriesParams{
+		WebhookID: []uuid.UUID{webhookID},
+	}, 1, 100)
+	if err != nil {
+		t.Fatalf("filter deliveries: %v", err)
+	}
+
+	return res.Data
+}
+
+func TestCreateWebhook(t *testing.T) {
+	cases := []struct {
+		name   string
+		url    string
+		secret string
+		want   error
+	}{
+		{name: "https with secret", url: "https://partner.example/hooks", secret: "s3cret"},
+		{name: "generated secret", url: "http://partner.example/hooks"},
+		{name: "other scheme", url: "ftp://partner.example/hooks", want: errx.ErrorInvalidWebhookURL},
+		{name: "relative", url: "/hooks", want: errx.ErrorInvalidWebhookURL},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s := newSetup(t)
+
+			w, err := s.webhook.Create(context.Background(), webhook.CreateParams{URL: tc.url, Secret: tc.secret})
+			if tc.want != nil {
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v, got %v", tc.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			if tc.secret != "" && w.Secret != tc.secret {
+				t.Errorf("secret = %q, want %q", w.Secret, tc.secret)
+			}
+			if tc.secret == "" && len(w.Secret) != 64 {
+				t.Errorf("generated secret %q is not 32 random bytes", w.Secret)
+			}
+		})
+	}
+}
+
+func TestEnqueueWebhookDeliveries(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	all := s.createWebhook(t)
+	created := s.createWebhook(t, "city.created")
+
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.created", []byte(`{"events":"city.created"}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.invite.accepted", []byte(`{}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	if got := len(s.deliveries(t, all.ID)); got != 2 {
+		t.Errorf("webhook without event types got %d deliveries, want 2", got)
+	}
+
+	got := s.deliveries(t, created.ID)
+	if len(got) != 1 || got[0].EventType != "city.created" || got[0].Status != enum.WebhookDeliveryStatusPending {
+		t.Fatalf("filtered webhook got %+v, want one pending city.created delivery", got)
+	}
+	if string(got[0].Payload) != `{"events":"city.created"}` {
+		t.Errorf("payload = %s", got[0].Payload)
+	}
+}
+
+func TestRecordWebhookAttempt(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	w := s.createWebhook(t)
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.created", []byte(`{}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	claimed, err := s.webhook.Claim(ctx, 10, time.Minute)
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("claim: %v, got %d deliveries, want 1", err, len(claimed))
+	}
+	if again, _ := s.webhook.Claim(ctx, 10, time.Minute); len(again) != 0 {
+		t.Fatalf("leased delivery was claimed again")
+	}
+
+	// failed attempts back off exponentially up to the cap, then the delivery is dead
+	d := claimed[0]
+	for i, wantDelay := range []time.Duration{time.Minute, 2 * time.Minute} {
+		before := time.Now().UTC()
+		d, err = s.webhook.RecordAttempt(ctx, d, 503, errors.New("endpoint responded 503"))
+		if err != nil {
+			t.Fatalf("record attempt %d: %v", i+1, err)
+		}
+
+		if d.Status != enum.WebhookDeliveryStatusPending || d.Attempts != i+1 {
+			t.Fatalf("after attempt %d got status %s with %d attempts", i+1, d.Status, d.Attempts)
+		}
+		if delay := d.NextAttemptAt.Sub(before); delay < wantDelay || delay > wantDelay+time.Second {
+			t.Errorf("attempt %d is retried after %s, want %s", i+1, delay, wantDelay)
+		}
+		if d.ResponseCode == nil || *d.ResponseCode != 503 || d.LastError == nil {
+			t.Errorf("attempt %d outcome is not recorded: %+v", i+1, d)
+		}
+	}
+
+	d, err = s.webhook.RecordAttempt(ctx, d, 0, errors.New("connection refused"))
+	if err != nil {
+		t.Fatalf("record last attempt: %v", err)
+	}
+	if d.Status != enum.WebhookDeliveryStatusDead || d.ResponseCode != nil {
+		t.Fatalf("after the last attempt got %+v, want a dead delivery without response code", d)
+	}
+
+	stored := s.deliveries(t, w.ID)
+	if len(stored) != 1 || stored[0].Status != enum.WebhookDeliveryStatusDead || stored[0].Attempts != 3 {
+		t.Fatalf("stored delivery %+v, want dead after 3 attempts", stored)
+	}
+}
+
+func TestRecordWebhookSuccess(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	s.createWebhook(t)
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.created", []byte(`{}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	claimed, err := s.webhook.Claim(ctx, 10, 0)
+	if err != nil || len(claimed) != 1 {
+		t.Fatalf("claim: %v, got %d deliveries, want 1", err, len(claimed))
+	}
+
+	d, err := s.webhook.RecordAttempt(ctx, claimed[0], 204, nil)
+	if err != nil {
+		t.Fatalf("record attempt: %v", err)
+	}
+	if d.Status != enum.WebhookDeliveryStatusDelivered || d.Attempts != 1 || d.LastError != nil {
+		t.Errorf("got %+v, want delivered after one attempt", d)
+	}
+
+	if again, _ := s.webhook.Claim(ctx, 10, 0); len(again) != 0 {
+		t.Errorf("delivered delivery was claimed again")
+	}
+}
+
+func TestRedeliverWebhook(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	w := s.createWebhook(t)
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.created", []byte(`{}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+	d := s.deliveries(t, w.ID)[0]
+
+	if _, err := s.webhook.Redeliver(ctx, d.ID); !errors.Is(err, errx.ErrorWebhookDeliveryNotDead) {
+		t.Fatalf("redeliver pending: expected %v, got %v", errx.ErrorWebhookDeliveryNotDead, err)
+	}
+	if _, err := s.webhook.Redeliver(ctx, uuid.New()); !errors.Is(err, errx.ErrorWebhookDeliveryNotFound) {
+		t.Fatalf("redeliver unknown: expected %v, got %v", errx.ErrorWebhookDeliveryNotFound, err)
+	}
+
+	for range webhookRetry.MaxAttempts {
+		var err error
+		if d, err = s.webhook.RecordAttempt(ctx, d, 500, errors.New("endpoint responded 500")); err != nil {
+			t.Fatalf("record attempt: %v", err)
+		}
+	}
+
+	dead, err := s.webhook.FilterDeliveries(ctx, webhook.FilterDeliveriesParams{
+		Status: []string{enum.WebhookDeliveryStatusDead},
+	}, 1, 10)
+	if err != nil || dead.Total != 1 {
+		t.Fatalf("dead letters: %v, got %d, want 1", err, dead.Total)
+	}
+
+	d, err = s.webhook.Redeliver(ctx, d.ID)
+	if err != nil {
+		t.Fatalf("redeliver: %v", err)
+	}
+	if d.Status != enum.WebhookDeliveryStatusPending || d.Attempts != 0 {
+		t.Errorf("redelivered %+v, want pending with no attempts", d)
+	}
+
+	claimed, err := s.webhook.Claim(ctx, 10, time.Minute)
+	if err != nil || len(claimed) != 1 || claimed[0].ID != d.ID {
+		t.Errorf("redelivered delivery is not due: %v, %+v", err, claimed)
+	}
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	s := newSetup(t)
+	ctx := context.Background()
+
+	w := s.createWebhook(t)
+	if err := s.webhook.Enqueue(ctx, uuid.New(), "city.created", []byte(`{}`)); err != nil {
+		t.Fatalf("enqueue: %v", err)
+	}
+
+	if err := s.webhook.Delete(ctx, w.ID); err != nil {
+		t.Fatalf("delete: %v", err)
+	}
+	if got := s.deliveries(t, w.ID); len(got) != 0 {
+		t.Errorf("deliveries of a deleted webhook are left: %+v", got)
+	}
+	if err := s.webhook.Delete(ctx, w.ID); !errors.Is(err, errx.ErrorWebhookNotFound) {
+		t.Errorf("delete again: expected %v, got %v", errx.ErrorWebhookNotFound, err)
+	}
+}
@@ -0,0 +1,249 @@
+// Package pgtest provisions a throwaway Postgres/PostGIS database for the tests
+// of a package and hands every test its own transaction which is rolled back
+// when the test ends, so tests can run in parallel without touching each
+// other's data.
+//
+// The database is created on the server given by TEST_DATABASE_URL. When the
+// variable is not set and initdb and pg_ctl are on PATH a temporary cluster is
+// started instead. Without either tests using the harness are skipped.
+package pgtest
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chains-lab/cities-svc/cmd/migrations"
+	"github.com/chains-lab/cities-svc/internal/repo/pgdb"
+	_ "github.com/lib/pq"
+)
+
+const EnvDatabaseURL = "TEST_DATABASE_URL"
+
+var (
+	db    *sql.DB
+	dbURL string
+	skip  string
+)
+
+// Main provisions the package database, runs the tests and drops the database
+// again. It is meant to be called from TestMain:
+//
+//	func TestMain(m *testing.M) { os.Exit(pgtest.Main(m)) }
+func Main(m *testing.M) int {
+	cleanup, err := setup()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "pgtest: %v\n", err)
+		return 1
+	}
+	defer cleanup()
+
+	return m.Run()
+}
+
+// DB returns the package database or skips the test if there is none.
+func DB(t testing.TB) *sql.DB {
+	t.Helper()
+
+	if db == nil {
+		if skip == "" {
+			t.Fatal("pgtest: database is not provisioned, call pgtest.Main from TestMain")
+		}
+		t.Skip(skip)
+	}
+
+	return db
+}
+
+// URL returns the connection URL of the package database or skips the test if
+// there is none.
+func URL(t testing.TB) string {
+	t.Helper()
+
+	DB(t)
+	return dbURL
+}
+
+// Tx begins a transaction which is rolled back when the test ends and returns
+// a context carrying it, queries made by pgdb with this context run inside it.
+func Tx(t testing.TB) context.Context {
+	t.Helper()
+
+	tx, err := DB(t).BeginTx(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("pgtest: begin transaction: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = tx.Rollback()
+	})
+
+	return context.WithValue(context.Background(), pgdb.TxKey, tx)
+}
+
+func setup() (func(), error) {
+	serverURL := os.Getenv(EnvDatabaseURL)
+	stopServer := func() {}
+
+	if serverURL == "" {
+		var err error
+		serverURL, stopServer, err = startLocal()
+		if err != nil {
+			skip = fmt.Sprintf("%s is not set and no local postgres could be started: %v", EnvDatabaseURL, err)
+			return func() {}, nil
+		}
+	}
+
+	name, err := databaseName()
+	if err != nil {
+		stopServer()
+		return nil, err
+	}
+
+	dropDatabase, u, err := createDatabase(serverURL, name)
+	if err != nil {
+		stopServer()
+		return nil, err
+	}
+
+	dbURL = u
+	cleanup := func() {
+		if db != nil {
+			_ = db.Close()
+		}
+		dropDatabase()
+		stopServer()
+	}
+
+	if err = migrations.MigrateUp(dbURL, migrations.Options{}); err != nil {
+		cleanup()
+		return nil, fmt.Errorf("migrate %s: %w", name, err)
+	}
+
+	db, err = sql.Open("postgres", dbURL)
+	if err != nil {
+		cleanup()
+		return nil, fmt.Errorf("open %s: %w", name, err)
+	}
+
+	return cleanup, nil
+}
+
+var unsafeChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// databaseName is unique per run and names the package under test, which is
+// the working directory of a test binary.
+func databaseName() (string, error) {
+	wd, err := os.Getwd()
+	if err != nil {
+		return "", fmt.Errorf("get working directory: %w", err)
+	}
+
+	suffix := make([]byte, 4)
+	if _, err = rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("generate database name: %w", err)
+	}
+
+	pkg := unsafeChars.ReplaceAllString(strings.ToLower(filepath.Base(wd)), "_")
+	name := fmt.Sprintf("cities_test_%s_%s", pkg, hex.EncodeToString(suffix))
+
+	return name[:min(len(name), 63)], nil
+}
+
+func createDatabase(serverURL, name string) (drop func(), dsn string, err error) {
+	u, err := url.Parse(serverURL)
+	if err != nil || u.Scheme == "" {
+		return nil, "", fmt.Errorf("%s must be a postgres:// URL", EnvDatabaseURL)
+	}
+
+	admin, err := sql.Open("postgres", serverURL)
+	if err != nil {
+		return nil, "", fmt.Errorf("open %s: %w", EnvDatabaseURL, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if _, err = admin.ExecContext(ctx, "CREATE DATABASE "+name); err != nil {
+		_ = admin.Close()
+		return nil, "", fmt.Errorf("create database %s: %w", name, err)
+	}
+
+	drop = func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		if _, err := admin.ExecContext(ctx, "DROP DATABASE IF EXISTS "+name+" WITH (FORCE)"); err != nil {
+			fmt.Fprintf(os.Stderr, "pgtest: drop database %s: %v\n", name, err)
+		}
+		_ = admin.Close()
+	}
+
+	u.Path = "/" + name
+	return drop, u.String(), nil
+}
+
+// startLocal initialises and starts a temporary cluster listening on a free
+// port on localhost, PostGIS has to be installed for the migrations to apply.
+func startLocal() (serverURL string, stop func(), err error) {
+	initdb, err := exec.LookPath("initdb")
+	if err != nil {
+		return "", nil, err
+	}
+	pgCtl, err := exec.LookPath("pg_ctl")
+	if err != nil {
+		return "", nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "pgtest")
+	if err != nil {
+		return "", nil, err
+	}
+	data := filepath.Join(dir, "data")
+
+	out, err := exec.Command(initdb, "-D", data, "-U", "postgres", "-A", "trust", "-N").CombinedOutput()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("initdb: %w: %s", err, out)
+	}
+
+	port, err := freePort()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, err
+	}
+
+	opts := fmt.Sprintf("-p %d -k %s -c listen_addresses=127.0.0.1 -F", port, dir)
+	out, err = exec.Command(pgCtl, "start", "-w", "-D", data, "-l", filepath.Join(dir, "postgres.log"), "-o", opts).CombinedOutput()
+	if err != nil {
+		_ = os.RemoveAll(dir)
+		return "", nil, fmt.Errorf("pg_ctl start: %w: %s", err, out)
+	}
+
+	stop = func() {
+		_ = exec.Command(pgCtl, "stop", "-w", "-m", "immediate", "-D", data).Run()
+		_ = os.RemoveAll(dir)
+	}
+
+	return fmt.Sprintf("postgres://postgres@127.0.0.1:%d/postgres?sslmode=disable", port), stop, nil
+}
+
+func freePort() (int, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return 0, err
+	}
+	defer l.Close()
+
+	return l.Addr().(*net.TCPAddr).Port, nil
+}